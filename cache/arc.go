@@ -0,0 +1,171 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/george-e-shaw-iv/go/linkedlist"
+)
+
+var _ Cache[string, string] = &ARC[string, string]{}
+
+// arcList identifies which of the four ARC lists an entry currently lives in.
+type arcList int
+
+const (
+	arcT1 arcList = iota
+	arcT2
+	arcB1
+	arcB2
+)
+
+type arcEntry[Key comparable, Value any] struct {
+	key   Key
+	value Value
+	list  arcList
+}
+
+// ARC is an Adaptive Replacement Cache. It splits its capacity between T1, entries that have
+// been seen once recently, and T2, entries that have been seen at least twice. The ghost lists
+// B1 and B2 remember the keys recently evicted from T1 and T2 respectively, and a hit on a
+// ghost shifts the target size of T1, p, towards whichever list would have kept the entry.
+type ARC[Key comparable, Value any] struct {
+	t1, t2, b1, b2 *linkedlist.DoubleHeadTail[*arcEntry[Key, Value]]
+	data           map[Key]*linkedlist.Node[*arcEntry[Key, Value]]
+	capacity       int
+	p              int
+	mu             sync.Mutex
+}
+
+func NewARC[Key comparable, Value any](capacity int) *ARC[Key, Value] {
+	return &ARC[Key, Value]{
+		t1:       linkedlist.NewDoubleHeadTail[*arcEntry[Key, Value]](),
+		t2:       linkedlist.NewDoubleHeadTail[*arcEntry[Key, Value]](),
+		b1:       linkedlist.NewDoubleHeadTail[*arcEntry[Key, Value]](),
+		b2:       linkedlist.NewDoubleHeadTail[*arcEntry[Key, Value]](),
+		data:     make(map[Key]*linkedlist.Node[*arcEntry[Key, Value]]),
+		capacity: capacity,
+	}
+}
+
+// P returns the current target size of T1, which the cache adapts as it observes hits on
+// its ghost lists. It is always between zero and the capacity of the cache.
+func (a *ARC[Key, Value]) P() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.p
+}
+
+func (a *ARC[Key, Value]) Get(k Key) (Value, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	n, exists := a.data[k]
+	if !exists || n.Data.list == arcB1 || n.Data.list == arcB2 {
+		// Ghosts only hold keys, so a ghost hit is still a miss.
+		var v Value
+		return v, ErrNotInCache
+	}
+
+	a.move(n, arcT2)
+	return n.Data.value, nil
+}
+
+func (a *ARC[Key, Value]) Put(k Key, v Value) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.capacity <= 0 {
+		return
+	}
+
+	if n, exists := a.data[k]; exists {
+		switch n.Data.list {
+		case arcB1:
+			// A recently evicted T1 entry was wanted again, so T1 should grow.
+			a.p = min(a.capacity, a.p+max(a.b2.Size()/a.b1.Size(), 1))
+			a.replace(false)
+		case arcB2:
+			// A recently evicted T2 entry was wanted again, so T2 should grow.
+			a.p = max(0, a.p-max(a.b1.Size()/a.b2.Size(), 1))
+			a.replace(true)
+		}
+
+		n.Data.value = v
+		a.move(n, arcT2)
+		return
+	}
+
+	switch l1, total := a.t1.Size()+a.b1.Size(), a.t1.Size()+a.t2.Size()+a.b1.Size()+a.b2.Size(); {
+	case l1 == a.capacity:
+		if a.t1.Size() < a.capacity {
+			a.drop(a.b1)
+			a.replace(false)
+		} else {
+			// B1 is empty and T1 is full, so the oldest T1 entry is forgotten entirely.
+			a.drop(a.t1)
+		}
+	case l1 < a.capacity && total >= a.capacity:
+		if total == 2*a.capacity {
+			a.drop(a.b2)
+		}
+		a.replace(false)
+	}
+
+	n := linkedlist.NewNode(&arcEntry[Key, Value]{
+		key:   k,
+		value: v,
+		list:  arcT1,
+	})
+	a.data[k] = n
+	a.t1.InsertFirst(n)
+}
+
+// replace makes room for a new entry in T1 or T2 by demoting the least recently used entry of
+// one of them to its ghost list. inB2 denotes whether the entry being made room for was a hit
+// on B2. The caller must hold a.mu.
+func (a *ARC[Key, Value]) replace(inB2 bool) {
+	if t1 := a.t1.Size(); t1 > 0 && (t1 > a.p || (inB2 && t1 == a.p)) {
+		a.move(a.t1.GetLast(), arcB1)
+		return
+	}
+
+	if a.t2.Size() > 0 {
+		a.move(a.t2.GetLast(), arcB2)
+	}
+}
+
+// move unlinks n from the list it is currently in and puts it at the front of to. Entries
+// moving onto a ghost list release their value. The caller must hold a.mu.
+func (a *ARC[Key, Value]) move(n *linkedlist.Node[*arcEntry[Key, Value]], to arcList) {
+	a.list(n.Data.list).Remove(n)
+
+	if to == arcB1 || to == arcB2 {
+		var v Value
+		n.Data.value = v
+	}
+
+	n.Data.list = to
+	a.list(to).InsertFirst(n)
+}
+
+// drop forgets the least recently used entry of l entirely. The caller must hold a.mu.
+func (a *ARC[Key, Value]) drop(l *linkedlist.DoubleHeadTail[*arcEntry[Key, Value]]) {
+	if last := l.GetLast(); last != nil {
+		delete(a.data, last.Data.key)
+		l.DeleteLast()
+	}
+}
+
+func (a *ARC[Key, Value]) list(l arcList) *linkedlist.DoubleHeadTail[*arcEntry[Key, Value]] {
+	switch l {
+	case arcT1:
+		return a.t1
+	case arcT2:
+		return a.t2
+	case arcB1:
+		return a.b1
+	default:
+		return a.b2
+	}
+}
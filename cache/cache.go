@@ -2,14 +2,65 @@ package cache
 
 import (
 	"errors"
-
-	"golang.org/x/exp/constraints"
+	"time"
 )
 
 // ErrNotInCache denotes that the provided key was not found in the cache.
 var ErrNotInCache = errors.New("key not in cache")
 
-type Cache[Key constraints.Ordered, Value comparable] interface {
+type Cache[Key comparable, Value any] interface {
 	Get(k Key) (Value, error)
 	Put(k Key, v Value)
 }
+
+type opts struct {
+	ttl time.Duration
+}
+
+func (o *opts) apply(cacheOptions ...Option) {
+	for i := range cacheOptions {
+		cacheOptions[i](o)
+	}
+}
+
+type Option func(*opts)
+
+// WithTTL sets the default time-to-live of every entry put into the cache. A ttl of
+// zero, which is the default, means entries never expire.
+func WithTTL(ttl time.Duration) Option {
+	return func(o *opts) {
+		o.ttl = ttl
+	}
+}
+
+// EvictReason describes why an entry left a cache.
+type EvictReason int
+
+const (
+	// EvictCapacity denotes that the entry was evicted to make room for other entries.
+	EvictCapacity EvictReason = iota
+
+	// EvictRemoved denotes that the entry was explicitly removed by the caller.
+	EvictRemoved
+
+	// EvictReplaced denotes that the entry was overwritten by a new value for the same key.
+	EvictReplaced
+
+	// EvictExpired denotes that the entry outlived its time-to-live.
+	EvictExpired
+)
+
+func (r EvictReason) String() string {
+	switch r {
+	case EvictCapacity:
+		return "capacity"
+	case EvictRemoved:
+		return "removed"
+	case EvictReplaced:
+		return "replaced"
+	case EvictExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
@@ -1,7 +1,15 @@
 package cache_test
 
 import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/george-e-shaw-iv/go/cache"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +26,25 @@ func TestCache(t *testing.T) {
 			Name:           "LRU",
 			Implementation: cache.NewLRU[string, string](capacity),
 		},
+		{
+			Name:           "LFU",
+			Implementation: cache.NewLFU[string, string](capacity),
+		},
+		{
+			Name:           "ARC",
+			Implementation: cache.NewARC[string, string](capacity),
+		},
+		{
+			// A single shard keeps recency global so the shared assertions hold.
+			Name:           "Sharded",
+			Implementation: cache.NewSharded[string, string](1, capacity),
+		},
+		{
+			Name: "Loading",
+			Implementation: cache.NewLoading[string, string](cache.NewLRU[string, string](capacity), func(string) (string, error) {
+				return "", cache.ErrNotInCache
+			}),
+		},
 	}
 
 	for _, test := range tt {
@@ -48,3 +75,887 @@ func TestCache(t *testing.T) {
 		})
 	}
 }
+
+func TestLRU_TTL(t *testing.T) {
+	const ttl = 20 * time.Millisecond
+
+	c := cache.NewLRU[string, string](3, cache.WithTTL(ttl))
+
+	c.Put("foo", "bar")
+	c.PutWithTTL("bar", "baz", time.Hour)
+	c.PutWithTTL("george", "shaw", 0)
+
+	foo, err := c.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", foo)
+
+	time.Sleep(2 * ttl)
+
+	// foo has expired but not been reclaimed yet, so it still counts towards Len but
+	// shouldn't be listed by Keys.
+	assert.Equal(t, 3, c.Len())
+	assert.ElementsMatch(t, []string{"bar", "george"}, c.Keys())
+
+	// The default ttl has elapsed, so foo should now be gone.
+	_, err = c.Get("foo")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+
+	// Entries with overridden ttls should still be present.
+	bar, err := c.Get("bar")
+	assert.NoError(t, err)
+	assert.Equal(t, "baz", bar)
+
+	george, err := c.Get("george")
+	assert.NoError(t, err)
+	assert.Equal(t, "shaw", george)
+}
+
+func TestLRU_Janitor(t *testing.T) {
+	const ttl = 10 * time.Millisecond
+
+	c := cache.NewLRU[string, string](3, cache.WithTTL(ttl))
+	c.StartJanitor(ttl)
+	t.Cleanup(c.StopJanitor)
+
+	c.PutWithTTL("bar", "baz", 0)
+	c.Put("foo", "bar")
+
+	// Give the janitor time to reclaim foo without it ever being read.
+	time.Sleep(5 * ttl)
+
+	// If foo was still taking up space these puts would push bar, the least recently
+	// used entry, out of the cache.
+	c.Put("george", "shaw")
+	c.Put("shaw", "george")
+
+	bar, err := c.Get("bar")
+	assert.NoError(t, err)
+	assert.Equal(t, "baz", bar)
+
+	// A janitor with no interval to run on is never started.
+	d := cache.NewLRU[string, string](1)
+	d.StartJanitor(0)
+	d.StopJanitor()
+}
+
+func TestLFU(t *testing.T) {
+	c := cache.NewLFU[string, string](2)
+
+	c.Put("foo", "bar")
+	c.Put("bar", "baz")
+
+	// Read foo twice so that it's used more frequently than bar.
+	_, _ = c.Get("foo")
+	_, _ = c.Get("foo")
+
+	// bar is the least frequently used entry so it should be evicted.
+	c.Put("george", "shaw")
+	_, err := c.Get("bar")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+
+	foo, err := c.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", foo)
+
+	// Ties between entries of the same frequency are broken by evicting the least recently
+	// used of them.
+	c = cache.NewLFU[string, string](2)
+	c.Put("foo", "bar")
+	c.Put("bar", "baz")
+	c.Put("george", "shaw")
+
+	_, err = c.Get("foo")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+
+	bar, err := c.Get("bar")
+	assert.NoError(t, err)
+	assert.Equal(t, "baz", bar)
+}
+
+func TestARC(t *testing.T) {
+	c := cache.NewARC[string, string](2)
+
+	// Promote foo into T2 by reading it, then fill T1 with bar.
+	c.Put("foo", "bar")
+	_, _ = c.Get("foo")
+	c.Put("bar", "baz")
+
+	// Making room for george demotes bar, the only T1 entry, to the B1 ghost list.
+	c.Put("george", "shaw")
+	_, err := c.Get("bar")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+	assert.Equal(t, 0, c.P())
+
+	// Putting bar again is a hit on B1, which grows the target size of T1. Room is made
+	// by demoting foo from T2 to B2.
+	c.Put("bar", "baz")
+	assert.Equal(t, 1, c.P())
+
+	_, err = c.Get("foo")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+
+	bar, err := c.Get("bar")
+	assert.NoError(t, err)
+	assert.Equal(t, "baz", bar)
+
+	george, err := c.Get("george")
+	assert.NoError(t, err)
+	assert.Equal(t, "shaw", george)
+
+	// Putting foo again is a hit on B2, which shrinks the target size of T1 back down.
+	c.Put("foo", "bar")
+	assert.Equal(t, 0, c.P())
+
+	foo, err := c.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", foo)
+}
+
+func TestSharded(t *testing.T) {
+	const keys = 100
+
+	// Give the shards enough headroom that an uneven spread of keys doesn't evict anything.
+	c := cache.NewSharded[int, string](8, 8*keys)
+
+	var wg sync.WaitGroup
+	for i := 0; i < keys; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c.Put(i, strconv.Itoa(i))
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < keys; i++ {
+		v, err := c.Get(i)
+		assert.NoError(t, err)
+		assert.Equal(t, strconv.Itoa(i), v)
+	}
+
+	t.Run("MoreShardsThanCapacity", func(t *testing.T) {
+		c := cache.NewSharded[int, string](16, 10)
+
+		// Every shard has room for at least one entry, so no key is dropped as soon as it is
+		// put.
+		for i := 0; i < 100; i++ {
+			c.Put(i, strconv.Itoa(i))
+
+			v, err := c.Get(i)
+			assert.NoError(t, err)
+			assert.Equal(t, strconv.Itoa(i), v)
+		}
+	})
+}
+
+// benchmarkParallel exercises c from every available goroutine with a mix of reads and writes.
+// Run with -cpu to compare how implementations scale with GOMAXPROCS.
+func benchmarkParallel(b *testing.B, c cache.Cache[int, int]) {
+	const keys = 1 << 10
+
+	for i := 0; i < keys; i++ {
+		c.Put(i, i)
+	}
+
+	// Each goroutine starts at a different point in the key sequence, so that they spread
+	// out across shards rather than moving through them in lockstep.
+	var goroutines atomic.Int64
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		offset := int(goroutines.Add(1)) * keys / 16
+		for i := 0; pb.Next(); i++ {
+			k := (offset + i*7919) % keys
+			if i%10 == 0 {
+				c.Put(k, i)
+				continue
+			}
+			_, _ = c.Get(k)
+		}
+	})
+}
+
+func BenchmarkLRU_Parallel(b *testing.B) {
+	benchmarkParallel(b, cache.NewLRU[int, int](1<<10))
+}
+
+func BenchmarkSharded_Parallel(b *testing.B) {
+	benchmarkParallel(b, cache.NewSharded[int, int](64, 1<<10))
+}
+
+func TestLoading(t *testing.T) {
+	t.Run("ConcurrentMissesLoadOnce", func(t *testing.T) {
+		const goroutines = 10
+
+		var calls atomic.Int32
+		release := make(chan struct{})
+
+		c := cache.NewLoading[string, string](cache.NewLRU[string, string](2), func(k string) (string, error) {
+			calls.Add(1)
+			<-release
+			return k + "bar", nil
+		})
+
+		var wg sync.WaitGroup
+		results := make([]string, goroutines)
+		for i := 0; i < goroutines; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], _ = c.Get("foo")
+			}(i)
+		}
+
+		// Give every goroutine the chance to miss before the load completes.
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls.Load())
+		for i := range results {
+			assert.Equal(t, "foobar", results[i])
+		}
+
+		// The loaded value should now be served from the cache.
+		foo, err := c.Get("foo")
+		assert.NoError(t, err)
+		assert.Equal(t, "foobar", foo)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("LoaderPanics", func(t *testing.T) {
+		var calls atomic.Int32
+		release := make(chan struct{})
+
+		c := cache.NewLoading[string, string](cache.NewLRU[string, string](2), func(string) (string, error) {
+			if calls.Add(1) == 1 {
+				<-release
+				panic("boom")
+			}
+			return "bar", nil
+		})
+
+		// The goroutine calling the loader should see the panic itself.
+		loaded := make(chan any)
+		go func() {
+			defer func() {
+				loaded <- recover()
+			}()
+			_, _ = c.Get("foo")
+		}()
+
+		// Wait for the load to start, then join it.
+		for calls.Load() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+
+		var (
+			wg  sync.WaitGroup
+			v   string
+			err error
+		)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err = c.Get("foo")
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+
+		assert.Equal(t, "boom", <-loaded)
+		wg.Wait()
+
+		// Waiters must not mistake the panic for a successful load.
+		assert.ErrorIs(t, err, cache.ErrLoaderPanicked)
+		assert.Empty(t, v)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("ErrorsAreNotCached", func(t *testing.T) {
+		errLoad := errors.New("load failed")
+
+		var calls atomic.Int32
+		c := cache.NewLoading[string, string](cache.NewLRU[string, string](2), func(string) (string, error) {
+			calls.Add(1)
+			return "", errLoad
+		})
+
+		_, err := c.Get("foo")
+		assert.ErrorIs(t, err, errLoad)
+		_, err = c.Get("foo")
+		assert.ErrorIs(t, err, errLoad)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("NegativeCaching", func(t *testing.T) {
+		const ttl = 20 * time.Millisecond
+		errLoad := errors.New("load failed")
+
+		var calls atomic.Int32
+		c := cache.NewLoading[string, string](cache.NewLRU[string, string](2), func(string) (string, error) {
+			calls.Add(1)
+			return "", errLoad
+		}, cache.WithNegativeCaching(2, ttl))
+
+		_, err := c.Get("foo")
+		assert.ErrorIs(t, err, errLoad)
+		_, err = c.Get("foo")
+		assert.ErrorIs(t, err, errLoad)
+		assert.Equal(t, int32(1), calls.Load())
+
+		// Once the error has expired the loader should be tried again.
+		time.Sleep(2 * ttl)
+		_, err = c.Get("foo")
+		assert.ErrorIs(t, err, errLoad)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
+
+func TestLRU_OnEvict(t *testing.T) {
+	type eviction struct {
+		Key, Value string
+		Reason     cache.EvictReason
+	}
+
+	var evictions []eviction
+
+	c := cache.NewLRU[string, string](2)
+	c.OnEvict(func(k, v string, reason cache.EvictReason) {
+		evictions = append(evictions, eviction{k, v, reason})
+
+		// The cache is unlocked by the time the hook runs, so using it shouldn't deadlock.
+		_, _ = c.Get(k)
+	})
+
+	c.Put("foo", "bar")
+	c.Put("foo", "baz")
+	c.Put("bar", "baz")
+	c.Put("george", "shaw")
+	c.PutWithTTL("shaw", "george", time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	c.RemoveExpired()
+
+	assert.Equal(t, []eviction{
+		{"foo", "bar", cache.EvictReplaced},
+		{"foo", "baz", cache.EvictCapacity},
+		{"bar", "baz", cache.EvictCapacity},
+		{"shaw", "george", cache.EvictExpired},
+	}, evictions)
+}
+
+func TestWeightedLRU(t *testing.T) {
+	c := cache.NewWeightedLRU[string, string](10, func(_, v string) int {
+		return len(v)
+	})
+
+	c.Put("foo", "1234")
+	c.Put("bar", "123")
+	c.Put("baz", "12")
+
+	// All three values fit within the capacity of 10.
+	for _, k := range []string{"foo", "bar", "baz"} {
+		_, err := c.Get(k)
+		assert.NoError(t, err)
+	}
+
+	// Pushing the total weight to 13 needs foo, the least recently used, to go.
+	c.Put("george", "1234")
+	_, err := c.Get("foo")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+
+	_, err = c.Get("bar")
+	assert.NoError(t, err)
+
+	// Values heavier than the whole capacity can't be stored at all, and don't push anything
+	// else out trying.
+	c.Put("shaw", "12345678901")
+	_, err = c.Get("shaw")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+	assert.ElementsMatch(t, []string{"bar", "baz", "george"}, c.Keys())
+
+	// Putting one over an existing key drops only the value it replaces.
+	c.Put("bar", "12345678901")
+	assert.ElementsMatch(t, []string{"baz", "george"}, c.Keys())
+}
+
+func TestLRU_Management(t *testing.T) {
+	c := cache.NewLRU[string, string](3)
+
+	var evictions []string
+	c.OnEvict(func(k, _ string, reason cache.EvictReason) {
+		evictions = append(evictions, k+":"+reason.String())
+	})
+
+	c.Put("foo", "bar")
+	c.Put("bar", "baz")
+	c.Put("george", "shaw")
+	assert.Equal(t, 3, c.Len())
+	assert.Equal(t, []string{"george", "bar", "foo"}, c.Keys())
+
+	// Peeking at foo shouldn't promote it.
+	foo, err := c.Peek("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", foo)
+	assert.Equal(t, []string{"george", "bar", "foo"}, c.Keys())
+
+	_, err = c.Peek("shaw")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+
+	c.Delete("bar")
+	c.Delete("shaw")
+	assert.Equal(t, []string{"george", "foo"}, c.Keys())
+
+	// Shrinking the cache should evict the least recently used entries straight away.
+	c.Put("bar", "baz")
+	c.Resize(1)
+	assert.Equal(t, []string{"bar"}, c.Keys())
+
+	c.Resize(2)
+	c.Put("foo", "bar")
+	assert.Equal(t, []string{"foo", "bar"}, c.Keys())
+
+	c.Purge()
+	assert.Zero(t, c.Len())
+	assert.Empty(t, c.Keys())
+
+	assert.Equal(t, []string{
+		"bar:removed",
+		"foo:capacity",
+		"george:capacity",
+		"foo:removed",
+		"bar:removed",
+	}, evictions)
+}
+
+func TestLRU_Stats(t *testing.T) {
+	c := cache.NewLRU[string, string](2)
+
+	c.Put("foo", "bar")
+	c.Put("bar", "baz")
+	c.Put("foo", "baz")
+	_, _ = c.Get("foo")
+	_, _ = c.Get("george")
+	c.Put("george", "shaw")
+
+	assert.Equal(t, cache.Stats{
+		Hits:      1,
+		Misses:    1,
+		Puts:      4,
+		Evictions: 1,
+		Size:      2,
+	}, c.Stats())
+	assert.Equal(t, 0.5, c.Stats().HitRatio())
+
+	// Resetting shouldn't touch the size, since the entries are still in the cache.
+	c.ResetStats()
+	assert.Equal(t, cache.Stats{Size: 2}, c.Stats())
+
+	c.Delete("foo")
+	assert.Equal(t, cache.Stats{Size: 1}, c.Stats())
+}
+
+func TestExpvarStats(t *testing.T) {
+	c := cache.NewLRU[string, string](2)
+	v := cache.ExpvarStats(c)
+
+	c.Put("foo", "bar")
+	_, _ = c.Get("foo")
+
+	assert.JSONEq(t, `{"Hits":1,"Misses":0,"Puts":1,"Evictions":0,"Size":1}`, v.String())
+}
+
+func TestCache_StructKeysAndSliceValues(t *testing.T) {
+	type key struct {
+		Name string
+		ID   int
+	}
+
+	tt := []struct {
+		Name           string
+		Implementation cache.Cache[key, []string]
+	}{
+		{
+			Name:           "LRU",
+			Implementation: cache.NewLRU[key, []string](2),
+		},
+		{
+			Name:           "LFU",
+			Implementation: cache.NewLFU[key, []string](2),
+		},
+		{
+			Name:           "ARC",
+			Implementation: cache.NewARC[key, []string](2),
+		},
+		{
+			Name:           "Sharded",
+			Implementation: cache.NewSharded[key, []string](4, 8),
+		},
+	}
+
+	for _, test := range tt {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			test.Implementation.Put(key{"foo", 1}, []string{"bar", "baz"})
+			test.Implementation.Put(key{"foo", 2}, []string{"george"})
+
+			foo, err := test.Implementation.Get(key{"foo", 1})
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"bar", "baz"}, foo)
+
+			foo, err = test.Implementation.Get(key{"foo", 2})
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"george"}, foo)
+
+			_, err = test.Implementation.Get(key{"foo", 3})
+			assert.ErrorIs(t, err, cache.ErrNotInCache)
+		})
+	}
+}
+
+func TestLRU_SnapshotAndRestore(t *testing.T) {
+	src := cache.NewLRU[string, []int](4)
+	src.Put("foo", []int{1})
+	src.Put("bar", []int{2, 3})
+	src.PutWithTTL("expired", []int{4}, time.Nanosecond)
+	src.Put("george", []int{5})
+	_, _ = src.Get("foo")
+
+	var buf bytes.Buffer
+	assert.NoError(t, src.Snapshot(&buf))
+
+	// Make sure the entry with the short ttl has expired by the time it's restored.
+	time.Sleep(time.Millisecond)
+
+	// The destination only has room for two entries, so only the two most recently used
+	// entries that haven't expired should make it in, in their original order.
+	dst := cache.NewLRU[string, []int](2)
+	assert.NoError(t, dst.Restore(&buf))
+	assert.Equal(t, []string{"foo", "george"}, dst.Keys())
+
+	foo, err := dst.Get("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, foo)
+}
+
+func TestLRU_RestoreBadSnapshot(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(struct {
+		Magic   string
+		Version int
+	}{"foobar", 1}))
+
+	c := cache.NewLRU[string, string](2)
+	assert.ErrorIs(t, c.Restore(&buf), cache.ErrBadSnapshot)
+}
+
+func TestTiered(t *testing.T) {
+	dir := t.TempDir()
+
+	c, err := cache.NewTiered[string, []string](dir, 1, 2)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, c.Close())
+	})
+
+	// Only the most recent entry fits in memory, the rest are demoted to disk until that
+	// is full as well, at which point foo is dropped.
+	c.Put("foo", []string{"bar"})
+	c.Put("bar", []string{"baz"})
+	c.Put("george", []string{"shaw"})
+	c.Put("shaw", []string{"george"})
+
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	_, err = c.Get("foo")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+
+	// bar is promoted back into memory, which demotes shaw to disk.
+	bar, err := c.Get("bar")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"baz"}, bar)
+
+	shaw, err := c.Get("shaw")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"george"}, shaw)
+
+	george, err := c.Get("george")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"shaw"}, george)
+
+	files, err = os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+}
+
+func TestTiered_TTL(t *testing.T) {
+	const ttl = 50 * time.Millisecond
+
+	c, err := cache.NewTiered[string, string](t.TempDir(), 1, 2, cache.WithTTL(ttl))
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, c.Close())
+	})
+
+	// foo is demoted to disk, and should expire there on schedule.
+	c.Put("foo", "1")
+	c.Put("bar", "2")
+	time.Sleep(ttl + 10*time.Millisecond)
+
+	_, err = c.Get("foo")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+
+	// george is promoted back into memory part way through its ttl, and should only have what
+	// was left of it rather than a new one.
+	c.Put("george", "3")
+	c.Put("shaw", "4")
+	time.Sleep(ttl / 2)
+
+	george, err := c.Get("george")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", george)
+
+	time.Sleep(ttl/2 + 10*time.Millisecond)
+	_, err = c.Get("george")
+	assert.ErrorIs(t, err, cache.ErrNotInCache)
+}
+
+// mapStore is an in-memory stand-in for a persistent cache.Store. Saves fail with err while
+// it is set.
+type mapStore struct {
+	data  map[string]string
+	saves int
+	err   error
+	mu    sync.Mutex
+}
+
+func newMapStore() *mapStore {
+	return &mapStore{
+		data: make(map[string]string),
+	}
+}
+
+func (m *mapStore) Load(k string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, exists := m.data[k]
+	if !exists {
+		return "", cache.ErrNotInCache
+	}
+	return v, nil
+}
+
+func (m *mapStore) Save(k, v string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+
+	m.data[k] = v
+	m.saves++
+	return nil
+}
+
+func (m *mapStore) Delete(k string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.data, k)
+	return nil
+}
+
+func (m *mapStore) setErr(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.err = err
+}
+
+func (m *mapStore) snapshot() (map[string]string, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data := make(map[string]string, len(m.data))
+	for k, v := range m.data {
+		data[k] = v
+	}
+	return data, m.saves
+}
+
+func TestStoreBacked(t *testing.T) {
+	t.Run("WriteThrough", func(t *testing.T) {
+		store := newMapStore()
+		store.data["george"] = "shaw"
+
+		c := cache.NewStoreBacked[string, string](store, 2)
+
+		// Misses should be loaded from the store.
+		george, err := c.Get("george")
+		assert.NoError(t, err)
+		assert.Equal(t, "shaw", george)
+
+		_, err = c.Get("foo")
+		assert.ErrorIs(t, err, cache.ErrNotInCache)
+
+		c.Put("foo", "bar")
+		data, saves := store.snapshot()
+		assert.Equal(t, map[string]string{"foo": "bar", "george": "shaw"}, data)
+		assert.Equal(t, 1, saves)
+
+		assert.NoError(t, c.Delete("george"))
+		data, _ = store.snapshot()
+		assert.Equal(t, map[string]string{"foo": "bar"}, data)
+
+		assert.NoError(t, c.Close())
+	})
+
+	t.Run("WriteBack", func(t *testing.T) {
+		store := newMapStore()
+		c := cache.NewStoreBacked[string, string](store, 2, cache.AsWriteBack(0))
+
+		c.Put("foo", "bar")
+		c.Put("foo", "baz")
+		c.Put("bar", "baz")
+
+		// Nothing should've been saved yet.
+		data, _ := store.snapshot()
+		assert.Empty(t, data)
+
+		// Evicting foo should save it.
+		c.Put("george", "shaw")
+		data, _ = store.snapshot()
+		assert.Equal(t, map[string]string{"foo": "baz"}, data)
+
+		assert.NoError(t, c.Flush())
+		data, saves := store.snapshot()
+		assert.Equal(t, map[string]string{"foo": "baz", "bar": "baz", "george": "shaw"}, data)
+		assert.Equal(t, 3, saves)
+
+		// Clean values shouldn't be saved again.
+		assert.NoError(t, c.Close())
+		_, saves = store.snapshot()
+		assert.Equal(t, 3, saves)
+	})
+
+	t.Run("FailedSaveOnEviction", func(t *testing.T) {
+		errStore := errors.New("store is down")
+
+		store := newMapStore()
+		c := cache.NewStoreBacked[string, string](store, 1, cache.AsWriteBack(0))
+
+		store.setErr(errStore)
+		c.Put("foo", "bar")
+		c.Put("george", "shaw")
+
+		// foo failed to save when george evicted it, but it hasn't been lost.
+		assert.ErrorIs(t, c.Flush(), errStore)
+		foo, err := c.Get("foo")
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", foo)
+		assert.ErrorIs(t, c.Flush(), errStore)
+
+		store.setErr(nil)
+		assert.NoError(t, c.Flush())
+		data, _ := store.snapshot()
+		assert.Equal(t, map[string]string{"foo": "bar", "george": "shaw"}, data)
+	})
+
+	t.Run("PeriodicFlush", func(t *testing.T) {
+		const interval = 10 * time.Millisecond
+
+		store := newMapStore()
+		c := cache.NewStoreBacked[string, string](store, 2, cache.AsWriteBack(interval))
+		t.Cleanup(func() {
+			assert.NoError(t, c.Close())
+			assert.NoError(t, c.Close())
+		})
+
+		c.Put("foo", "bar")
+		assert.Eventually(t, func() bool {
+			data, _ := store.snapshot()
+			return data["foo"] == "bar"
+		}, time.Second, interval)
+	})
+}
+
+func TestTinyLFU(t *testing.T) {
+	t.Run("GetAndPut", func(t *testing.T) {
+		c := cache.NewTinyLFU[string, string](2)
+
+		_, err := c.Get("foo")
+		assert.ErrorIs(t, err, cache.ErrNotInCache)
+
+		c.Put("foo", "bar")
+		foo, err := c.Get("foo")
+		assert.NoError(t, err)
+		assert.Equal(t, "bar", foo)
+
+		c.Put("foo", "baz")
+		foo, err = c.Get("foo")
+		assert.NoError(t, err)
+		assert.Equal(t, "baz", foo)
+	})
+
+	t.Run("ScanResistance", func(t *testing.T) {
+		const (
+			capacity = 100
+			hot      = 10
+		)
+
+		c := cache.NewTinyLFU[int, int](capacity)
+
+		// Build up the frequency of the hot keys.
+		for i := 0; i < 5; i++ {
+			for k := 0; k < hot; k++ {
+				if _, err := c.Get(k); err != nil {
+					c.Put(k, k)
+				}
+			}
+		}
+
+		// A scan over many keys that are only ever seen once shouldn't be admitted over
+		// the hot keys.
+		for k := hot; k < 10*capacity; k++ {
+			c.Put(k, k)
+		}
+
+		for k := 0; k < hot; k++ {
+			v, err := c.Get(k)
+			assert.NoError(t, err)
+			assert.Equal(t, k, v)
+		}
+	})
+}
+
+func TestLRU_All(t *testing.T) {
+	c := cache.NewLRU[string, string](3)
+	c.Put("foo", "bar")
+	c.Put("bar", "baz")
+	c.PutWithTTL("expired", "entry", time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	var keys, values []string
+	for k, v := range c.All() {
+		keys = append(keys, k)
+		values = append(values, v)
+
+		// The cache is free to change during iteration.
+		c.Put(k+k, v)
+	}
+	assert.Equal(t, []string{"bar", "foo"}, keys)
+	assert.Equal(t, []string{"baz", "bar"}, values)
+
+	// Stopping early shouldn't visit the rest of the cache.
+	keys = nil
+	for k := range c.All() {
+		keys = append(keys, k)
+		break
+	}
+	assert.Equal(t, []string{"foofoo"}, keys)
+}
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// hashKey hashes k with seed. Keys that are == to each other always hash the same.
+func hashKey[Key comparable](seed maphash.Seed, k Key) uint64 {
+	// Fast path for the most common key types, which avoids going through reflection and
+	// setting up a maphash.Hash.
+	switch k := any(k).(type) {
+	case string:
+		return maphash.String(seed, k)
+	case int:
+		b := uint64Bytes(uint64(k))
+		return maphash.Bytes(seed, b[:])
+	}
+
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeValue(&h, reflect.ValueOf(k))
+	return h.Sum64()
+}
+
+// writeValue writes v to h such that any two comparable values that are == to each other
+// write the same bytes.
+func writeValue(h *maphash.Hash, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat64(h, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat64(h, real(v.Complex()))
+		writeFloat64(h, imag(v.Complex()))
+	case reflect.String:
+		// Prefix the length so that adjacent strings in structs and arrays can't run together.
+		writeUint64(h, uint64(v.Len()))
+		h.WriteString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint64(h, uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeValue(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeValue(h, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			h.WriteByte(0)
+			return
+		}
+		h.WriteString(v.Elem().Type().String())
+		writeValue(h, v.Elem())
+	}
+}
+
+func writeFloat64(h *maphash.Hash, f float64) {
+	if f == 0 {
+		// Positive and negative zero are equal keys, so they need to hash the same.
+		f = 0
+	}
+	writeUint64(h, math.Float64bits(f))
+}
+
+func writeUint64(h *maphash.Hash, u uint64) {
+	b := uint64Bytes(u)
+	h.Write(b[:])
+}
+
+func uint64Bytes(u uint64) [8]byte {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], u)
+	return b
+}
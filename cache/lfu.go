@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/george-e-shaw-iv/go/linkedlist"
+)
+
+var _ Cache[string, string] = &LFU[string, string]{}
+
+type lfuEntry[Key comparable, Value any] struct {
+	key   Key
+	value Value
+	freq  int
+}
+
+// LFU is a least frequently used cache. Entries are grouped into buckets by how many times
+// they have been accessed, and each bucket is kept in recency order so that ties between
+// entries of the same frequency are broken by evicting the least recently used of them.
+//
+// Both Get and Put run in constant time.
+type LFU[Key comparable, Value any] struct {
+	buckets  map[int]*linkedlist.DoubleHeadTail[*lfuEntry[Key, Value]]
+	data     map[Key]*linkedlist.Node[*lfuEntry[Key, Value]]
+	minFreq  int
+	capacity int
+	mu       sync.Mutex
+}
+
+func NewLFU[Key comparable, Value any](capacity int) *LFU[Key, Value] {
+	return &LFU[Key, Value]{
+		buckets:  make(map[int]*linkedlist.DoubleHeadTail[*lfuEntry[Key, Value]]),
+		data:     make(map[Key]*linkedlist.Node[*lfuEntry[Key, Value]]),
+		capacity: capacity,
+	}
+}
+
+func (l *LFU[Key, Value]) Get(k Key) (Value, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	n, exists := l.data[k]
+	if !exists {
+		var v Value
+		return v, ErrNotInCache
+	}
+
+	l.touch(n)
+	return n.Data.value, nil
+}
+
+func (l *LFU[Key, Value]) Put(k Key, v Value) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.capacity <= 0 {
+		return
+	}
+
+	if n, exists := l.data[k]; exists {
+		n.Data.value = v
+		l.touch(n)
+		return
+	}
+
+	if len(l.data) >= l.capacity {
+		// The least recently used entry of the lowest frequency sits at the back of its bucket.
+		bucket := l.buckets[l.minFreq]
+		delete(l.data, bucket.GetLast().Data.key)
+		bucket.DeleteLast()
+		if bucket.Size() == 0 {
+			delete(l.buckets, l.minFreq)
+		}
+	}
+
+	n := linkedlist.NewNode(&lfuEntry[Key, Value]{
+		key:   k,
+		value: v,
+		freq:  1,
+	})
+	l.data[k] = n
+	l.bucket(1).InsertFirst(n)
+	l.minFreq = 1
+}
+
+// touch moves n from its current frequency bucket to the front of the next one up. The
+// caller must hold l.mu.
+func (l *LFU[Key, Value]) touch(n *linkedlist.Node[*lfuEntry[Key, Value]]) {
+	freq := n.Data.freq
+
+	bucket := l.buckets[freq]
+	bucket.Remove(n)
+	if bucket.Size() == 0 {
+		delete(l.buckets, freq)
+		if l.minFreq == freq {
+			l.minFreq++
+		}
+	}
+
+	n.Data.freq++
+	l.bucket(n.Data.freq).InsertFirst(n)
+}
+
+// bucket returns the bucket for freq, creating it if it doesn't exist yet. The caller must
+// hold l.mu.
+func (l *LFU[Key, Value]) bucket(freq int) *linkedlist.DoubleHeadTail[*lfuEntry[Key, Value]] {
+	if _, exists := l.buckets[freq]; !exists {
+		l.buckets[freq] = linkedlist.NewDoubleHeadTail[*lfuEntry[Key, Value]]()
+	}
+	return l.buckets[freq]
+}
@@ -0,0 +1,165 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var _ Cache[string, string] = &Loading[string, string]{}
+
+// ErrLoaderPanicked is returned to every goroutine that was waiting on a load when the loader
+// panicked. The goroutine that called the loader panics with the original value.
+var ErrLoaderPanicked = errors.New("cache loader panicked")
+
+// Loader computes the value for a key that isn't in the cache.
+type Loader[Key comparable, Value any] func(k Key) (Value, error)
+
+// call is an in-flight or completed call to a Loader that any number of goroutines can wait on.
+type call[Value any] struct {
+	wg    sync.WaitGroup
+	value Value
+	err   error
+}
+
+type loadingOpts struct {
+	negativeCapacity int
+	negativeTTL      time.Duration
+}
+
+func (o *loadingOpts) apply(loadingOptions ...LoadingOption) {
+	for i := range loadingOptions {
+		loadingOptions[i](o)
+	}
+}
+
+type LoadingOption func(*loadingOpts)
+
+// WithNegativeCaching makes the cache remember up to capacity errors returned by the
+// loader for ttl, returning them to callers instead of calling the loader again. A ttl of
+// zero remembers errors until they are pushed out by newer ones or their key is Put.
+func WithNegativeCaching(capacity int, ttl time.Duration) LoadingOption {
+	return func(o *loadingOpts) {
+		o.negativeCapacity = capacity
+		o.negativeTTL = ttl
+	}
+}
+
+// Loading wraps a cache so that misses are filled by a loader. When many goroutines miss
+// on the same key at the same time the loader is only called once, and every one of them
+// receives its result.
+type Loading[Key comparable, Value any] struct {
+	cache  Cache[Key, Value]
+	loader Loader[Key, Value]
+
+	// negative is nil unless negative caching is enabled.
+	negative *LRU[Key, error]
+
+	calls map[Key]*call[Value]
+	mu    sync.Mutex
+}
+
+func NewLoading[Key comparable, Value any](cache Cache[Key, Value], loader Loader[Key, Value], options ...LoadingOption) *Loading[Key, Value] {
+	o := loadingOpts{}
+	o.apply(options...)
+
+	l := Loading[Key, Value]{
+		cache:  cache,
+		loader: loader,
+		calls:  make(map[Key]*call[Value]),
+	}
+
+	if o.negativeCapacity > 0 {
+		l.negative = NewLRU[Key, error](o.negativeCapacity, WithTTL(o.negativeTTL))
+	}
+
+	return &l
+}
+
+// Get returns the value stored under k, calling the loader to fill the cache if it isn't
+// there. Errors returned by the loader are passed through to every caller waiting on k.
+func (l *Loading[Key, Value]) Get(k Key) (Value, error) {
+	if v, err := l.cache.Get(k); err == nil {
+		return v, nil
+	}
+
+	if l.negative != nil {
+		if err, cacheErr := l.negative.Get(k); cacheErr == nil {
+			var v Value
+			return v, err
+		}
+	}
+
+	l.mu.Lock()
+	if c, exists := l.calls[k]; exists {
+		// Someone else is already loading this key, wait for them to finish.
+		l.mu.Unlock()
+		c.wg.Wait()
+		return c.value, c.err
+	}
+
+	// A load for k may have finished between missing the cache above and taking the lock, in
+	// which case its result is in the cache and its call is already gone.
+	if v, err := l.cache.Get(k); err == nil {
+		l.mu.Unlock()
+		return v, nil
+	}
+	if l.negative != nil {
+		if err, cacheErr := l.negative.Get(k); cacheErr == nil {
+			l.mu.Unlock()
+			var v Value
+			return v, err
+		}
+	}
+
+	c := &call[Value]{}
+	c.wg.Add(1)
+	l.calls[k] = c
+	l.mu.Unlock()
+
+	l.load(k, c)
+	return c.value, c.err
+}
+
+// load calls the loader for k and stores its result in c. If the loader panics, anyone
+// waiting on c is released with ErrLoaderPanicked and the panic carries on up through the
+// calling goroutine.
+func (l *Loading[Key, Value]) load(k Key, c *call[Value]) {
+	returned := false
+	defer func() {
+		var recovered any
+		if !returned {
+			// recover is nil if the loader called runtime.Goexit, which waiters need to hear
+			// about just the same.
+			recovered = recover()
+			c.err = fmt.Errorf("%w: %v", ErrLoaderPanicked, recovered)
+		}
+
+		l.mu.Lock()
+		delete(l.calls, k)
+		l.mu.Unlock()
+		c.wg.Done()
+
+		if recovered != nil {
+			panic(recovered)
+		}
+	}()
+
+	c.value, c.err = l.loader(k)
+	returned = true
+	if c.err == nil {
+		l.cache.Put(k, c.value)
+	} else if l.negative != nil {
+		l.negative.Put(k, c.err)
+	}
+}
+
+// Put stores v under k in the underlying cache, forgetting any error remembered for k.
+func (l *Loading[Key, Value]) Put(k Key, v Value) {
+	l.cache.Put(k, v)
+
+	if l.negative != nil {
+		l.negative.Delete(k)
+	}
+}
@@ -1,73 +1,397 @@
 package cache
 
 import (
+	"iter"
 	"sync"
+	"time"
 
 	"github.com/george-e-shaw-iv/go/linkedlist"
-	"golang.org/x/exp/constraints"
 )
 
-var _ Cache[string, string] = &LRU[string, string]{}
+var (
+	_ Cache[string, string] = &LRU[string, string]{}
+	_ StatsReporter         = &LRU[string, string]{}
+)
 
-type kv[Key constraints.Ordered, Value comparable] struct {
+type kv[Key comparable, Value any] struct {
 	key   Key
 	value Value
+	cost  int
+
+	// expiresAt is the zero time for entries that never expire.
+	expiresAt time.Time
+}
+
+func (e *kv[Key, Value]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
 }
 
-type LRU[Key constraints.Ordered, Value comparable] struct {
-	ll       *linkedlist.DoubleHeadTail[kv[Key, Value]]
-	data     map[Key]*linkedlist.Node[kv[Key, Value]]
-	capacity int
-	mu       sync.Mutex
+// eviction is an entry that has left the cache but hasn't been reported to the OnEvict hook yet.
+type eviction[Key comparable, Value any] struct {
+	key       Key
+	value     Value
+	expiresAt time.Time
+	reason    EvictReason
 }
 
-func NewLRU[Key constraints.Ordered, Value comparable](capacity int) *LRU[Key, Value] {
-	return &LRU[Key, Value]{
-		ll:       linkedlist.NewDoubleHeadTail[kv[Key, Value]](),
-		data:     make(map[Key]*linkedlist.Node[kv[Key, Value]]),
+type LRU[Key comparable, Value any] struct {
+	ll   *linkedlist.DoubleHeadTail[*kv[Key, Value]]
+	data map[Key]*linkedlist.Node[*kv[Key, Value]]
+	opts *opts
+	mu   sync.Mutex
+
+	// capacity is the maximum total cost of the entries in the cache, and size is their
+	// current total cost. Without a cost function every entry costs 1.
+	capacity, size int
+	cost           func(Key, Value) int
+
+	// onEvict is called with every eviction in pending once mu has been released, so that
+	// it is free to call back into the cache.
+	onEvict func(Key, Value, EvictReason)
+	pending []eviction[Key, Value]
+
+	// onEvictEntry is like onEvict, but also gets when the entry would have expired. It is
+	// for caches in this package built on top of an LRU.
+	onEvictEntry func(eviction[Key, Value])
+
+	// stopJanitor and janitorDone are non-nil while the janitor is running.
+	stopJanitor, janitorDone chan struct{}
+
+	stats stats
+}
+
+func NewLRU[Key comparable, Value any](capacity int, options ...Option) *LRU[Key, Value] {
+	l := LRU[Key, Value]{
+		ll:       linkedlist.NewDoubleHeadTail[*kv[Key, Value]](),
+		data:     make(map[Key]*linkedlist.Node[*kv[Key, Value]]),
 		capacity: capacity,
+		opts:     &opts{},
 	}
+	l.opts.apply(options...)
+
+	return &l
 }
 
-func (l *LRU[Key, Value]) Get(k Key) (Value, error) {
+// NewWeightedLRU returns an LRU whose capacity is a total weight rather than a number of
+// entries, where the weight of each entry is given by cost. Least recently used entries are
+// evicted until the total weight of the cache is back within capacity.
+func NewWeightedLRU[Key comparable, Value any](capacity int, cost func(Key, Value) int, options ...Option) *LRU[Key, Value] {
+	l := NewLRU[Key, Value](capacity, options...)
+	l.cost = cost
+	return l
+}
+
+// OnEvict registers fn to be called every time an entry leaves the cache, along with the
+// reason it left. fn is called after the cache has been unlocked, so it may use the cache.
+func (l *LRU[Key, Value]) OnEvict(fn func(k Key, v Value, reason EvictReason)) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	if _, exists := l.data[k]; !exists {
+	l.onEvict = fn
+}
+
+func (l *LRU[Key, Value]) Get(k Key) (Value, error) {
+	l.mu.Lock()
+	defer l.unlock()
+
+	n, exists := l.data[k]
+	if !exists {
+		l.stats.misses.Add(1)
+
 		var v Value
 		return v, ErrNotInCache
 	}
 
+	if n.Data.expired(time.Now()) {
+		l.remove(n, EvictExpired)
+		l.stats.misses.Add(1)
+
+		var v Value
+		return v, ErrNotInCache
+	}
+
+	l.stats.hits.Add(1)
+
 	// Put entry at the front of the list.
-	l.ll.Delete(l.data[k].Data)
-	l.ll.InsertFirst(l.data[k])
+	l.ll.MoveToFront(n)
 
-	return l.data[k].Data.value, nil
+	return n.Data.value, nil
 }
 
+// Put stores v under k using the default ttl of the cache.
 func (l *LRU[Key, Value]) Put(k Key, v Value) {
+	l.PutWithTTL(k, v, l.opts.ttl)
+}
+
+// PutWithTTL stores v under k, overriding the default ttl of the cache for this entry
+// only. A ttl of zero means the entry never expires.
+func (l *LRU[Key, Value]) PutWithTTL(k Key, v Value, ttl time.Duration) {
 	l.mu.Lock()
-	defer l.mu.Unlock()
+	defer l.unlock()
 
-	data := kv[Key, Value]{
+	data := &kv[Key, Value]{
 		key:   k,
 		value: v,
+		cost:  1,
+	}
+
+	if l.cost != nil {
+		data.cost = l.cost(k, v)
+	}
+
+	if ttl > 0 {
+		data.expiresAt = time.Now().Add(ttl)
+	}
+
+	l.stats.puts.Add(1)
+
+	if data.cost > l.capacity {
+		// An entry that costs more than the whole capacity can't be stored, so it is evicted
+		// straight away rather than pushing every other entry out first. Only the value it
+		// replaces goes with it.
+		if n, exists := l.data[k]; exists {
+			l.remove(n, EvictReplaced)
+		}
+		l.evicted(data, EvictCapacity)
+		l.stats.evictions.Add(1)
+		return
 	}
 
 	if n, exists := l.data[k]; exists {
-		l.ll.Delete(n.Data) // Delete the existing entry.
+		l.evicted(n.Data, EvictReplaced)
+		l.size += data.cost - n.Data.cost
+
 		n.Data = data       // Overwrite the data in the existing.
-		l.ll.InsertFirst(n) // Put the node with the new data at the head of the list.
-		l.data[k] = n       // Save the node with the new data.
+		l.ll.MoveToFront(n) // Put the node with the new data at the head of the list.
+	} else {
+		n := linkedlist.NewNode(data)
+		l.data[k] = n
+		l.ll.InsertFirst(n)
+		l.size += data.cost
+		l.stats.size.Add(1)
+	}
+
+	l.shrink()
+}
+
+// Peek returns the value stored under k without marking it as recently used.
+func (l *LRU[Key, Value]) Peek(k Key) (Value, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	n, exists := l.data[k]
+	if !exists || n.Data.expired(time.Now()) {
+		var v Value
+		return v, ErrNotInCache
+	}
+
+	return n.Data.value, nil
+}
+
+// Delete removes k from the cache, if it exists.
+func (l *LRU[Key, Value]) Delete(k Key) {
+	l.mu.Lock()
+	defer l.unlock()
+
+	if n, exists := l.data[k]; exists {
+		l.remove(n, EvictRemoved)
+	}
+}
+
+// Len returns the number of entries in the cache. Expired entries count until they are
+// reclaimed, either by being looked up or by the janitor, so Len can be more than the number
+// of keys returned by Keys.
+func (l *LRU[Key, Value]) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.ll.Size()
+}
+
+// Keys returns the keys in the cache ordered from most to least recently used, leaving out
+// any that have expired.
+func (l *LRU[Key, Value]) Keys() []Key {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	keys := make([]Key, 0, l.ll.Size())
+	for cur := l.ll.GetFirst(); cur != nil; cur = cur.Next {
+		if !cur.Data.expired(now) {
+			keys = append(keys, cur.Data.key)
+		}
+	}
+	return keys
+}
+
+// All returns an iterator over the entries in the cache ordered from most to least recently
+// used. Iterating doesn't mark entries as used. It iterates over a snapshot taken when
+// iteration starts, so the cache is free to change during iteration.
+func (l *LRU[Key, Value]) All() iter.Seq2[Key, Value] {
+	return func(yield func(Key, Value) bool) {
+		l.mu.Lock()
+		now := time.Now()
+		entries := make([]kv[Key, Value], 0, l.ll.Size())
+		for cur := l.ll.GetFirst(); cur != nil; cur = cur.Next {
+			if !cur.Data.expired(now) {
+				entries = append(entries, *cur.Data)
+			}
+		}
+		l.mu.Unlock()
+
+		for i := range entries {
+			if !yield(entries[i].key, entries[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// Resize changes the capacity of the cache, immediately evicting the least recently used
+// entries if the cache no longer fits.
+func (l *LRU[Key, Value]) Resize(capacity int) {
+	l.mu.Lock()
+	defer l.unlock()
+
+	l.capacity = capacity
+	l.shrink()
+}
+
+// Purge removes every entry from the cache.
+func (l *LRU[Key, Value]) Purge() {
+	l.mu.Lock()
+	defer l.unlock()
+
+	for cur := l.ll.GetFirst(); cur != nil; cur = cur.Next {
+		l.evicted(cur.Data, EvictRemoved)
+	}
+
+	l.ll.Clear()
+	clear(l.data)
+	l.size = 0
+	l.stats.size.Store(0)
+}
+
+// Stats returns a snapshot of the statistics of the cache.
+func (l *LRU[Key, Value]) Stats() Stats {
+	return l.stats.snapshot()
+}
+
+// ResetStats zeroes the hit, miss, put and eviction counters of the cache.
+func (l *LRU[Key, Value]) ResetStats() {
+	l.stats.reset()
+}
+
+// StartJanitor starts a background goroutine that removes expired entries from the cache
+// every interval, without waiting for them to be pushed out by capacity pressure. Calling
+// StartJanitor while the janitor is already running, or with an interval that isn't
+// positive, has no effect.
+func (l *LRU[Key, Value]) StartJanitor(interval time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.stopJanitor != nil || interval <= 0 {
 		return
 	}
 
-	n := linkedlist.NewNode(data)
-	l.data[k] = n
-	l.ll.InsertFirst(n)
+	stop, done := make(chan struct{}), make(chan struct{})
+	l.stopJanitor, l.janitorDone = stop, done
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				l.RemoveExpired()
+			}
+		}
+	}()
+}
+
+// StopJanitor stops the janitor started by StartJanitor and waits for it to exit.
+func (l *LRU[Key, Value]) StopJanitor() {
+	l.mu.Lock()
+	stop, done := l.stopJanitor, l.janitorDone
+	l.stopJanitor, l.janitorDone = nil, nil
+	l.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+
+	close(stop)
+	<-done
+}
+
+// RemoveExpired removes every expired entry from the cache.
+func (l *LRU[Key, Value]) RemoveExpired() {
+	l.mu.Lock()
+	defer l.unlock()
+
+	now := time.Now()
+	for cur := l.ll.GetFirst(); cur != nil; {
+		// Grab the next node before cur is potentially unlinked from the list.
+		next := cur.Next
+		if cur.Data.expired(now) {
+			l.remove(cur, EvictExpired)
+		}
+		cur = next
+	}
+}
+
+// remove removes the entry held by n from the cache. The caller must hold l.mu.
+func (l *LRU[Key, Value]) remove(n *linkedlist.Node[*kv[Key, Value]], reason EvictReason) {
+	delete(l.data, n.Data.key)
+	l.ll.Remove(n)
+	l.size -= n.Data.cost
+	l.stats.size.Add(-1)
+	l.evicted(n.Data, reason)
+
+	if reason == EvictCapacity || reason == EvictExpired {
+		l.stats.evictions.Add(1)
+	}
+}
+
+// shrink evicts least recently used entries until the cache is within its capacity. The
+// caller must hold l.mu.
+func (l *LRU[Key, Value]) shrink() {
+	for l.size > l.capacity && l.ll.Size() > 0 {
+		l.remove(l.ll.GetLast(), EvictCapacity)
+	}
+}
+
+// evicted queues data to be reported to the OnEvict hook. The caller must hold l.mu.
+func (l *LRU[Key, Value]) evicted(data *kv[Key, Value], reason EvictReason) {
+	if l.onEvict == nil && l.onEvictEntry == nil {
+		return
+	}
+
+	l.pending = append(l.pending, eviction[Key, Value]{
+		key:       data.key,
+		value:     data.value,
+		expiresAt: data.expiresAt,
+		reason:    reason,
+	})
+}
+
+// unlock releases l.mu and then reports any evictions that happened while it was held.
+func (l *LRU[Key, Value]) unlock() {
+	onEvict, onEvictEntry, pending := l.onEvict, l.onEvictEntry, l.pending
+	l.pending = nil
+	l.mu.Unlock()
 
-	if l.ll.Size() > l.capacity {
-		delete(l.data, l.ll.GetLast().Data.key)
-		l.ll.DeleteLast()
+	for i := range pending {
+		if onEvict != nil {
+			onEvict(pending[i].key, pending[i].value, pending[i].reason)
+		}
+		if onEvictEntry != nil {
+			onEvictEntry(pending[i])
+		}
 	}
 }
@@ -0,0 +1,51 @@
+package cache
+
+import "hash/maphash"
+
+var _ Cache[string, string] = &Sharded[string, string]{}
+
+// Sharded is a cache that spreads its keys across a number of independent LRU shards, each
+// with its own lock and an even slice of the total capacity. Goroutines working on keys in
+// different shards never contend with each other.
+//
+// Recency is tracked per shard, so the entry evicted when a shard fills up is the least
+// recently used entry of that shard rather than of the whole cache.
+type Sharded[Key comparable, Value any] struct {
+	shards []*LRU[Key, Value]
+	seed   maphash.Seed
+}
+
+// NewSharded returns a cache with the given total capacity split across shards LRUs. Any
+// options are applied to every shard. There are never more shards than capacity, since a
+// shard with no capacity would never store the keys that hash to it.
+func NewSharded[Key comparable, Value any](shards, capacity int, options ...Option) *Sharded[Key, Value] {
+	shards = max(min(shards, capacity), 1)
+
+	s := Sharded[Key, Value]{
+		shards: make([]*LRU[Key, Value], shards),
+		seed:   maphash.MakeSeed(),
+	}
+
+	for i := range s.shards {
+		// Spread the remainder across the first shards so the total capacity is honoured.
+		shardCapacity := capacity / shards
+		if i < capacity%shards {
+			shardCapacity++
+		}
+		s.shards[i] = NewLRU[Key, Value](shardCapacity, options...)
+	}
+
+	return &s
+}
+
+func (s *Sharded[Key, Value]) Get(k Key) (Value, error) {
+	return s.shard(k).Get(k)
+}
+
+func (s *Sharded[Key, Value]) Put(k Key, v Value) {
+	s.shard(k).Put(k, v)
+}
+
+func (s *Sharded[Key, Value]) shard(k Key) *LRU[Key, Value] {
+	return s.shards[hashKey(s.seed, k)%uint64(len(s.shards))]
+}
@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/george-e-shaw-iv/go/linkedlist"
+)
+
+// ErrBadSnapshot denotes that a snapshot wasn't written by Snapshot, or was written in a
+// version of the format that this package can't read.
+var ErrBadSnapshot = errors.New("bad cache snapshot")
+
+const (
+	snapshotMagic   = "george-e-shaw-iv/go/cache.LRU"
+	snapshotVersion = 1
+)
+
+type snapshotHeader struct {
+	Magic   string
+	Version int
+	Len     int
+}
+
+type snapshotEntry[Key comparable, Value any] struct {
+	Key       Key
+	Value     Value
+	ExpiresAt time.Time
+}
+
+// Snapshot writes the contents of the cache to w, ordered from most to least recently used,
+// in a versioned gob format that Restore can read back. Keys and values must be encodable by
+// encoding/gob, which means concrete types stored behind interfaces need to be registered
+// with gob.Register.
+func (l *LRU[Key, Value]) Snapshot(w io.Writer) error {
+	// Copy the entries out first so that a slow writer doesn't hold up the cache.
+	l.mu.Lock()
+	entries := make([]snapshotEntry[Key, Value], 0, l.ll.Size())
+	for cur := l.ll.GetFirst(); cur != nil; cur = cur.Next {
+		entries = append(entries, snapshotEntry[Key, Value]{
+			Key:       cur.Data.key,
+			Value:     cur.Data.value,
+			ExpiresAt: cur.Data.expiresAt,
+		})
+	}
+	l.mu.Unlock()
+
+	enc := gob.NewEncoder(w)
+	if err := enc.Encode(snapshotHeader{
+		Magic:   snapshotMagic,
+		Version: snapshotVersion,
+		Len:     len(entries),
+	}); err != nil {
+		return fmt.Errorf("encode snapshot header: %w", err)
+	}
+
+	for i := range entries {
+		if err := enc.Encode(entries[i]); err != nil {
+			return fmt.Errorf("encode snapshot entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Restore reads a snapshot written by Snapshot from r into the cache. Restored entries are
+// treated as less recently used than anything already in the cache, and keys already in the
+// cache keep their current values. Entries that have expired since the snapshot was taken are
+// skipped, as are the least recently used entries that don't fit within the capacity.
+func (l *LRU[Key, Value]) Restore(r io.Reader) error {
+	dec := gob.NewDecoder(r)
+
+	var header snapshotHeader
+	if err := dec.Decode(&header); err != nil {
+		return fmt.Errorf("decode snapshot header: %w", err)
+	}
+
+	if header.Magic != snapshotMagic || header.Version != snapshotVersion {
+		return fmt.Errorf("%w: %q version %d", ErrBadSnapshot, header.Magic, header.Version)
+	}
+
+	// Decode everything before touching the cache so that a truncated snapshot leaves it as
+	// it was.
+	var entries []snapshotEntry[Key, Value]
+	for i := 0; i < header.Len; i++ {
+		var entry snapshotEntry[Key, Value]
+		if err := dec.Decode(&entry); err != nil {
+			return fmt.Errorf("decode snapshot entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	l.mu.Lock()
+	defer l.unlock()
+
+	now := time.Now()
+	for i := range entries {
+		data := &kv[Key, Value]{
+			key:       entries[i].Key,
+			value:     entries[i].Value,
+			cost:      1,
+			expiresAt: entries[i].ExpiresAt,
+		}
+
+		if l.cost != nil {
+			data.cost = l.cost(data.key, data.value)
+		}
+
+		if _, exists := l.data[data.key]; exists || data.expired(now) || l.size+data.cost > l.capacity {
+			continue
+		}
+
+		n := linkedlist.NewNode(data)
+		l.data[data.key] = n
+		l.ll.InsertLast(n)
+		l.size += data.cost
+		l.stats.size.Add(1)
+	}
+
+	return nil
+}
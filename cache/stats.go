@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"expvar"
+	"sync/atomic"
+)
+
+// Stats is a snapshot of how a cache has been performing.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+	Puts   uint64
+
+	// Evictions is the number of entries that left the cache without being explicitly
+	// removed or replaced, either due to capacity pressure or because they expired.
+	Evictions uint64
+
+	// Size is the number of entries in the cache when the snapshot was taken.
+	Size int64
+}
+
+// HitRatio returns the fraction of lookups that were hits, or zero if there haven't been any.
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// StatsReporter is implemented by caches that keep statistics.
+type StatsReporter interface {
+	Stats() Stats
+}
+
+// ExpvarStats adapts r into an expvar.Var that reports a fresh snapshot of its statistics
+// every time it is read, for use with expvar.Publish.
+func ExpvarStats(r StatsReporter) expvar.Var {
+	return expvar.Func(func() any {
+		return r.Stats()
+	})
+}
+
+// stats are the counters behind Stats. They are atomic so they can be read without taking
+// the lock of the cache they belong to.
+type stats struct {
+	hits, misses, puts, evictions atomic.Uint64
+	size                          atomic.Int64
+}
+
+func (s *stats) snapshot() Stats {
+	return Stats{
+		Hits:      s.hits.Load(),
+		Misses:    s.misses.Load(),
+		Puts:      s.puts.Load(),
+		Evictions: s.evictions.Load(),
+		Size:      s.size.Load(),
+	}
+}
+
+// reset zeroes every counter apart from size, which reflects the contents of the cache
+// rather than its history.
+func (s *stats) reset() {
+	s.hits.Store(0)
+	s.misses.Store(0)
+	s.puts.Store(0)
+	s.evictions.Store(0)
+}
@@ -0,0 +1,235 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var _ Cache[string, string] = &StoreBacked[string, string]{}
+
+// Store is the persistent storage that a StoreBacked cache sits in front of. Load should
+// return ErrNotInCache for keys that the store doesn't hold.
+type Store[Key comparable, Value any] interface {
+	Load(k Key) (Value, error)
+	Save(k Key, v Value) error
+	Delete(k Key) error
+}
+
+// WritePolicy decides when a StoreBacked cache saves the values put into it.
+type WritePolicy int
+
+const (
+	// WriteThrough saves every value to the store as it is put into the cache.
+	WriteThrough WritePolicy = iota
+
+	// WriteBack only marks values as dirty when they are put into the cache. Dirty values
+	// are saved when they are evicted, on every flush interval and when Flush or Close
+	// is called.
+	WriteBack
+)
+
+type storeOpts struct {
+	policy        WritePolicy
+	flushInterval time.Duration
+}
+
+func (o *storeOpts) apply(storeOptions ...StoreOption) {
+	for i := range storeOptions {
+		storeOptions[i](o)
+	}
+}
+
+type StoreOption func(*storeOpts)
+
+// AsWriteBack makes the cache use the WriteBack policy, saving dirty values in a batch every
+// interval on top of when they are evicted. An interval of zero disables periodic flushing.
+func AsWriteBack(interval time.Duration) StoreOption {
+	return func(o *storeOpts) {
+		o.policy = WriteBack
+		o.flushInterval = interval
+	}
+}
+
+// StoreBacked is a cache that fronts a Store with an LRU. Misses are loaded from the store,
+// and values put into the cache are saved to the store according to its WritePolicy.
+//
+// The Cache interface has no way to report errors from Put, so errors saving to the store
+// from Put, eviction or a periodic flush are kept and returned from the next Flush or Close.
+type StoreBacked[Key comparable, Value any] struct {
+	lru   *LRU[Key, Value]
+	store Store[Key, Value]
+	opts  *storeOpts
+
+	// dirty holds the keys with values that haven't been saved to the store yet. Those that
+	// failed to save as they were evicted from the LRU are kept in pending until they do.
+	dirty   map[Key]struct{}
+	pending map[Key]Value
+	errs    []error
+	mu      sync.Mutex
+
+	// stopFlusher and flusherDone are non-nil while the periodic flusher is running.
+	stopFlusher, flusherDone chan struct{}
+}
+
+// NewStoreBacked returns a cache holding up to capacity values from store in memory. It uses
+// the WriteThrough policy unless AsWriteBack is passed.
+func NewStoreBacked[Key comparable, Value any](store Store[Key, Value], capacity int, options ...StoreOption) *StoreBacked[Key, Value] {
+	s := StoreBacked[Key, Value]{
+		lru:     NewLRU[Key, Value](capacity),
+		store:   store,
+		opts:    &storeOpts{},
+		dirty:   make(map[Key]struct{}),
+		pending: make(map[Key]Value),
+	}
+	s.opts.apply(options...)
+
+	// The hook runs synchronously within lru.Put, so it is covered by s.mu.
+	s.lru.OnEvict(func(k Key, v Value, reason EvictReason) {
+		if reason != EvictCapacity {
+			return
+		}
+
+		if _, dirty := s.dirty[k]; dirty {
+			if !s.save(k, v) {
+				s.pending[k] = v
+			}
+		}
+	})
+
+	if s.opts.policy == WriteBack && s.opts.flushInterval > 0 {
+		s.stopFlusher, s.flusherDone = make(chan struct{}), make(chan struct{})
+		go s.flusher()
+	}
+
+	return &s
+}
+
+// Get returns the value stored under k, loading it from the store on a miss. Errors from
+// the store are returned as they are.
+func (s *StoreBacked[Key, Value]) Get(k Key) (Value, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, err := s.lru.Get(k); err == nil {
+		return v, nil
+	}
+
+	// A value that failed to save is newer than whatever the store holds.
+	if v, exists := s.pending[k]; exists {
+		delete(s.pending, k)
+		s.lru.Put(k, v)
+		return v, nil
+	}
+
+	v, err := s.store.Load(k)
+	if err != nil {
+		return v, err
+	}
+
+	s.lru.Put(k, v)
+	return v, nil
+}
+
+func (s *StoreBacked[Key, Value]) Put(k Key, v Value) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The value being put replaces any that is waiting to be saved.
+	delete(s.pending, k)
+
+	switch s.opts.policy {
+	case WriteThrough:
+		s.save(k, v)
+	case WriteBack:
+		s.dirty[k] = struct{}{}
+	}
+
+	s.lru.Put(k, v)
+}
+
+// Delete removes k from both the cache and the store, discarding any unsaved value.
+func (s *StoreBacked[Key, Value]) Delete(k Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.dirty, k)
+	delete(s.pending, k)
+	s.lru.Delete(k)
+	return s.store.Delete(k)
+}
+
+// Flush saves every dirty value to the store, returning any errors encountered saving values
+// since the last call to Flush.
+func (s *StoreBacked[Key, Value]) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.flush()
+
+	err := errors.Join(s.errs...)
+	s.errs = nil
+	return err
+}
+
+// Close stops the periodic flusher, if there is one, and flushes the cache. The cache must
+// not be used afterwards, other than to call Close again.
+func (s *StoreBacked[Key, Value]) Close() error {
+	if s.stopFlusher != nil {
+		close(s.stopFlusher)
+		<-s.flusherDone
+		s.stopFlusher, s.flusherDone = nil, nil
+	}
+
+	return s.Flush()
+}
+
+func (s *StoreBacked[Key, Value]) flusher() {
+	defer close(s.flusherDone)
+
+	ticker := time.NewTicker(s.opts.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopFlusher:
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			s.flush()
+			s.mu.Unlock()
+		}
+	}
+}
+
+// flush saves every dirty value to the store. The caller must hold s.mu.
+func (s *StoreBacked[Key, Value]) flush() {
+	for k := range s.dirty {
+		if v, exists := s.pending[k]; exists {
+			if s.save(k, v) {
+				delete(s.pending, k)
+			}
+			continue
+		}
+
+		v, err := s.lru.Peek(k)
+		if err != nil {
+			// Nothing holds the value any more, so there is nothing left to save.
+			delete(s.dirty, k)
+			continue
+		}
+		s.save(k, v)
+	}
+}
+
+// save writes v to the store under k, marking k as dirty if that fails so that the next
+// flush retries it. It returns whether the save succeeded. The caller must hold s.mu.
+func (s *StoreBacked[Key, Value]) save(k Key, v Value) bool {
+	if err := s.store.Save(k, v); err != nil {
+		s.errs = append(s.errs, err)
+		s.dirty[k] = struct{}{}
+		return false
+	}
+	delete(s.dirty, k)
+	return true
+}
@@ -0,0 +1,223 @@
+package cache
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/george-e-shaw-iv/go/linkedlist"
+)
+
+var _ Cache[string, string] = &Tiered[string, string]{}
+
+// Tiered is a two level cache. An in-memory LRU sits in front of a second tier that keeps
+// each of its values in a file within a local directory. Entries evicted from memory for
+// capacity are demoted to disk, and entries found on disk are promoted back into memory.
+// Entries keep the time they expire at as they move between the tiers.
+//
+// The disk tier is a cache like any other, so a value that can't be written to or read
+// back from disk is dropped rather than reported. Values must be encodable by encoding/gob.
+type Tiered[Key comparable, Value any] struct {
+	memory *LRU[Key, Value]
+	disk   *disk[Key, Value]
+
+	// mu serializes moving entries between the tiers.
+	mu sync.Mutex
+}
+
+// NewTiered returns a Tiered cache that holds up to memoryCapacity entries in memory and
+// up to diskCapacity entries in files within dir, creating dir if it doesn't exist. Any
+// options are applied to the in-memory tier.
+func NewTiered[Key comparable, Value any](dir string, memoryCapacity, diskCapacity int, options ...Option) (*Tiered[Key, Value], error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create disk tier directory: %w", err)
+	}
+
+	t := Tiered[Key, Value]{
+		memory: NewLRU[Key, Value](memoryCapacity, options...),
+		disk: &disk[Key, Value]{
+			dir:      dir,
+			ll:       linkedlist.NewDoubleHeadTail[*diskEntry[Key]](),
+			data:     make(map[Key]*linkedlist.Node[*diskEntry[Key]]),
+			capacity: diskCapacity,
+		},
+	}
+
+	// The hook runs synchronously within memory.Put, so it is covered by t.mu. It is set
+	// directly rather than through OnEvict so that it gets the expiry of each entry, and so
+	// that OnEvict is left free for callers.
+	t.memory.onEvictEntry = func(e eviction[Key, Value]) {
+		if e.reason == EvictCapacity && (e.expiresAt.IsZero() || time.Now().Before(e.expiresAt)) {
+			t.disk.put(e.key, e.value, e.expiresAt)
+		}
+	}
+
+	return &t, nil
+}
+
+func (t *Tiered[Key, Value]) Get(k Key) (Value, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if v, err := t.memory.Get(k); err == nil {
+		return v, nil
+	}
+
+	now := time.Now()
+	v, expiresAt, err := t.disk.take(k, now)
+	if err != nil {
+		return v, err
+	}
+
+	// Carry over whatever is left of the entry's ttl rather than starting a new one.
+	var ttl time.Duration
+	if !expiresAt.IsZero() {
+		ttl = expiresAt.Sub(now)
+	}
+
+	t.memory.PutWithTTL(k, v, ttl)
+	return v, nil
+}
+
+func (t *Tiered[Key, Value]) Put(k Key, v Value) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Whatever is on disk for k is stale now.
+	t.disk.delete(k)
+	t.memory.Put(k, v)
+}
+
+// Close removes every file written by the disk tier. The cache must not be used afterwards.
+func (t *Tiered[Key, Value]) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var errs []error
+	for cur := t.disk.ll.GetFirst(); cur != nil; cur = cur.Next {
+		if err := os.Remove(cur.Data.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+
+	t.memory.Purge()
+	t.disk.ll.Clear()
+	clear(t.disk.data)
+
+	return errors.Join(errs...)
+}
+
+type diskEntry[Key comparable] struct {
+	key  Key
+	path string
+
+	// expiresAt is the zero time for entries that never expire.
+	expiresAt time.Time
+}
+
+// disk is the second tier of a Tiered cache. It keeps its values in files and only the
+// index of those files, in recency order, in memory. It relies on the lock of its Tiered.
+type disk[Key comparable, Value any] struct {
+	dir      string
+	ll       *linkedlist.DoubleHeadTail[*diskEntry[Key]]
+	data     map[Key]*linkedlist.Node[*diskEntry[Key]]
+	capacity int
+
+	// seq names the next file to be written. Files are never reused so that a half written
+	// file can't be mistaken for the value of another key.
+	seq uint64
+}
+
+func (d *disk[Key, Value]) put(k Key, v Value, expiresAt time.Time) {
+	d.delete(k)
+
+	if d.capacity <= 0 {
+		return
+	}
+
+	d.seq++
+	path := filepath.Join(d.dir, strconv.FormatUint(d.seq, 10)+".gob")
+	if err := writeGob(path, v); err != nil {
+		return
+	}
+
+	n := linkedlist.NewNode(&diskEntry[Key]{
+		key:       k,
+		path:      path,
+		expiresAt: expiresAt,
+	})
+	d.data[k] = n
+	d.ll.InsertFirst(n)
+
+	if d.ll.Size() > d.capacity {
+		d.delete(d.ll.GetLast().Data.key)
+	}
+}
+
+// take reads the value stored under k, along with when it expires, and removes it from the
+// disk tier. Entries that have expired as of now are removed without being read.
+func (d *disk[Key, Value]) take(k Key, now time.Time) (Value, time.Time, error) {
+	var v Value
+
+	n, exists := d.data[k]
+	if !exists {
+		return v, time.Time{}, ErrNotInCache
+	}
+
+	expiresAt := n.Data.expiresAt
+	if !expiresAt.IsZero() && !now.Before(expiresAt) {
+		d.delete(k)
+		return v, time.Time{}, ErrNotInCache
+	}
+
+	err := readGob(n.Data.path, &v)
+	d.delete(k)
+	if err != nil {
+		return v, time.Time{}, ErrNotInCache
+	}
+
+	return v, expiresAt, nil
+}
+
+func (d *disk[Key, Value]) delete(k Key) {
+	n, exists := d.data[k]
+	if !exists {
+		return
+	}
+
+	delete(d.data, k)
+	d.ll.Remove(n)
+	_ = os.Remove(n.Data.path)
+}
+
+func writeGob(path string, v any) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = gob.NewEncoder(f).Encode(v)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(path)
+	}
+	return err
+}
+
+func readGob(path string, v any) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return gob.NewDecoder(f).Decode(v)
+}
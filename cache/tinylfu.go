@@ -0,0 +1,246 @@
+package cache
+
+import (
+	"hash/maphash"
+	"math/bits"
+	"sync"
+
+	"github.com/george-e-shaw-iv/go/linkedlist"
+)
+
+var _ Cache[string, string] = &TinyLFU[string, string]{}
+
+// sketchDepth is the number of rows in a countMinSketch. Each key has one counter per row.
+const sketchDepth = 4
+
+// sketchMaxCount is the value a countMinSketch counter saturates at.
+const sketchMaxCount = 15
+
+// countMinSketch estimates how often keys have been seen using a fixed amount of memory. The
+// estimate for a key is the smallest of its counters, so it can only ever be an overestimate
+// caused by other keys sharing those counters.
+//
+// Every time the number of increments reaches the sample size every counter is halved, which
+// ages out keys that were popular in the past but aren't anymore.
+type countMinSketch struct {
+	rows              [sketchDepth][]uint8
+	mask              uint64
+	additions, sample int
+}
+
+func newCountMinSketch(capacity int) *countMinSketch {
+	// Aging kicks in after ten increments per entry, so give each entry a few counters per
+	// row to keep collisions from inflating estimates before then. The width is rounded up
+	// to a power of two so that indexing is just a mask.
+	width := uint64(1) << bits.Len(uint(8*max(capacity, 1)-1))
+
+	s := countMinSketch{
+		mask:   width - 1,
+		sample: 10 * max(capacity, 1),
+	}
+	for i := range s.rows {
+		s.rows[i] = make([]uint8, width)
+	}
+	return &s
+}
+
+// index returns the counter of the given row for the key with hash h. Each row uses a
+// different combination of the two halves of h so that keys colliding in one row are
+// unlikely to collide in the others.
+func (s *countMinSketch) index(h uint64, row int) uint64 {
+	h1, h2 := h, (h>>32)|1
+	return (h1 + uint64(row)*h2) & s.mask
+}
+
+func (s *countMinSketch) increment(h uint64) {
+	for i := range s.rows {
+		if idx := s.index(h, i); s.rows[i][idx] < sketchMaxCount {
+			s.rows[i][idx]++
+		}
+	}
+
+	s.additions++
+	if s.additions >= s.sample {
+		s.age()
+	}
+}
+
+func (s *countMinSketch) estimate(h uint64) uint8 {
+	est := uint8(sketchMaxCount)
+	for i := range s.rows {
+		est = min(est, s.rows[i][s.index(h, i)])
+	}
+	return est
+}
+
+func (s *countMinSketch) age() {
+	for i := range s.rows {
+		for j := range s.rows[i] {
+			s.rows[i][j] /= 2
+		}
+	}
+	s.additions /= 2
+}
+
+// tinyLFUSegment identifies which of the TinyLFU lists an entry currently lives in.
+type tinyLFUSegment int
+
+const (
+	segmentWindow tinyLFUSegment = iota
+	segmentProbation
+	segmentProtected
+)
+
+type tinyLFUEntry[Key comparable, Value any] struct {
+	key     Key
+	value   Value
+	hash    uint64
+	segment tinyLFUSegment
+}
+
+// TinyLFU is a W-TinyLFU cache. New entries land in a small LRU admission window. Entries
+// pushed out of the window have to compete to get into the main region, and only make it
+// in if a frequency sketch estimates they are used more often than the entry that would be
+// evicted to make room for them. This stops one-off scans from flushing out hot entries.
+//
+// The main region is a segmented LRU. Entries start on probation and are promoted to the
+// protected segment when they are used again, so that only entries that have proven
+// themselves can push other protected entries back onto probation.
+type TinyLFU[Key comparable, Value any] struct {
+	window, probation, protected *linkedlist.DoubleHeadTail[*tinyLFUEntry[Key, Value]]
+	data                         map[Key]*linkedlist.Node[*tinyLFUEntry[Key, Value]]
+
+	sketch *countMinSketch
+	seed   maphash.Seed
+
+	windowCapacity, probationCapacity, protectedCapacity int
+
+	mu sync.Mutex
+}
+
+// NewTinyLFU returns a TinyLFU cache holding up to capacity entries. 1% of the capacity goes
+// to the admission window, and of the rest 80% goes to the protected segment.
+func NewTinyLFU[Key comparable, Value any](capacity int) *TinyLFU[Key, Value] {
+	capacity = max(capacity, 0)
+
+	windowCapacity := min(max(capacity/100, 1), capacity)
+	mainCapacity := capacity - windowCapacity
+	protectedCapacity := mainCapacity * 8 / 10
+
+	return &TinyLFU[Key, Value]{
+		window:            linkedlist.NewDoubleHeadTail[*tinyLFUEntry[Key, Value]](),
+		probation:         linkedlist.NewDoubleHeadTail[*tinyLFUEntry[Key, Value]](),
+		protected:         linkedlist.NewDoubleHeadTail[*tinyLFUEntry[Key, Value]](),
+		data:              make(map[Key]*linkedlist.Node[*tinyLFUEntry[Key, Value]]),
+		sketch:            newCountMinSketch(capacity),
+		seed:              maphash.MakeSeed(),
+		windowCapacity:    windowCapacity,
+		probationCapacity: mainCapacity - protectedCapacity,
+		protectedCapacity: protectedCapacity,
+	}
+}
+
+func (t *TinyLFU[Key, Value]) Get(k Key) (Value, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	n, exists := t.data[k]
+	if !exists {
+		// Misses count too, otherwise a new key could never build up enough frequency to
+		// beat an entry already in the cache.
+		t.sketch.increment(hashKey(t.seed, k))
+
+		var v Value
+		return v, ErrNotInCache
+	}
+
+	t.sketch.increment(n.Data.hash)
+	t.touch(n)
+	return n.Data.value, nil
+}
+
+func (t *TinyLFU[Key, Value]) Put(k Key, v Value) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if n, exists := t.data[k]; exists {
+		t.sketch.increment(n.Data.hash)
+		n.Data.value = v
+		t.touch(n)
+		return
+	}
+
+	h := hashKey(t.seed, k)
+	t.sketch.increment(h)
+
+	if t.windowCapacity == 0 {
+		return
+	}
+
+	n := linkedlist.NewNode(&tinyLFUEntry[Key, Value]{
+		key:     k,
+		value:   v,
+		hash:    h,
+		segment: segmentWindow,
+	})
+	t.data[k] = n
+	t.window.InsertFirst(n)
+
+	if t.window.Size() > t.windowCapacity {
+		candidate := t.window.GetLast()
+		t.window.DeleteLast()
+		t.admit(candidate)
+	}
+}
+
+// admit decides whether candidate, which has just been pushed out of the window, makes it
+// into the main region. The caller must hold t.mu.
+func (t *TinyLFU[Key, Value]) admit(candidate *linkedlist.Node[*tinyLFUEntry[Key, Value]]) {
+	if t.probation.Size()+t.protected.Size() < t.probationCapacity+t.protectedCapacity {
+		// There's still room, so there's no need to evict anything.
+		candidate.Data.segment = segmentProbation
+		t.probation.InsertFirst(candidate)
+		return
+	}
+
+	victims := t.probation
+	if victims.Size() == 0 {
+		victims = t.protected
+	}
+
+	victim := victims.GetLast()
+	if victim == nil || t.sketch.estimate(candidate.Data.hash) <= t.sketch.estimate(victim.Data.hash) {
+		// Ties go to the victim, since it has already proven itself by making it this far.
+		delete(t.data, candidate.Data.key)
+		return
+	}
+
+	delete(t.data, victim.Data.key)
+	victims.DeleteLast()
+
+	candidate.Data.segment = segmentProbation
+	t.probation.InsertFirst(candidate)
+}
+
+// touch records that the entry held by n was just used. The caller must hold t.mu.
+func (t *TinyLFU[Key, Value]) touch(n *linkedlist.Node[*tinyLFUEntry[Key, Value]]) {
+	switch n.Data.segment {
+	case segmentWindow:
+		t.window.MoveToFront(n)
+	case segmentProtected:
+		t.protected.MoveToFront(n)
+	case segmentProbation:
+		// Being used again while on probation earns a promotion, which may push the least
+		// recently used protected entry back onto probation.
+		t.probation.Remove(n)
+		n.Data.segment = segmentProtected
+		t.protected.InsertFirst(n)
+
+		for t.protected.Size() > t.protectedCapacity {
+			demoted := t.protected.GetLast()
+			t.protected.DeleteLast()
+			demoted.Data.segment = segmentProbation
+			t.probation.InsertFirst(demoted)
+		}
+	}
+}
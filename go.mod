@@ -1,11 +1,8 @@
 module github.com/george-e-shaw-iv/go
 
-go 1.22.2
+go 1.23.0
 
-require (
-	github.com/stretchr/testify v1.9.0
-	golang.org/x/exp v0.0.0-20240409090435-93d18d7e34b8
-)
+require github.com/stretchr/testify v1.9.0
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
@@ -3,6 +3,7 @@ package graph
 import (
 	"fmt"
 	"io"
+	"iter"
 )
 
 var _ Graph = &Adjacency{}
@@ -190,3 +191,83 @@ func (a *Adjacency) TopologicalSort() ([]int, error) {
 	}
 	return order, err
 }
+
+// Nodes returns an iterator over every node in the graph, in no particular order.
+func (a *Adjacency) Nodes() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for node := range a.data {
+			if !yield(node) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iterator over every edge in the graph as from, to pairs, in no particular
+// order. Undirected edges are yielded once in each direction.
+func (a *Adjacency) Edges() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for from, edges := range a.data {
+			for _, to := range edges {
+				if !yield(from, to) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// WalkBFS returns an iterator over the nodes reachable from start in the same order as BFS,
+// only visiting as much of the graph as the caller consumes.
+func (a *Adjacency) WalkBFS(start int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if _, exists := a.data[start]; !exists {
+			return
+		}
+
+		visited := map[int]struct{}{start: {}}
+		queue := []int{start}
+
+		for len(queue) != 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			if !yield(current) {
+				return
+			}
+
+			for _, edge := range a.data[current] {
+				if _, exists := visited[edge]; !exists {
+					visited[edge] = struct{}{}
+					queue = append(queue, edge)
+				}
+			}
+		}
+	}
+}
+
+// WalkDFS returns an iterator over the nodes reachable from start in the same order as
+// DFSIterative, only visiting as much of the graph as the caller consumes.
+func (a *Adjacency) WalkDFS(start int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if _, exists := a.data[start]; !exists {
+			return
+		}
+
+		stack := []int{start}
+		visited := make(map[int]struct{})
+
+		for len(stack) != 0 {
+			currentNode := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if _, exists := visited[currentNode]; !exists {
+				visited[currentNode] = struct{}{}
+				if !yield(currentNode) {
+					return
+				}
+				stack = append(stack, a.data[currentNode]...)
+			}
+		}
+	}
+}
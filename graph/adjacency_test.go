@@ -3,9 +3,11 @@ package graph_test
 import (
 	"fmt"
 	"os"
+	"slices"
 	"testing"
 
 	"github.com/george-e-shaw-iv/go/graph"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAdjancency(t *testing.T) {
@@ -28,3 +30,35 @@ func TestAdjancency(t *testing.T) {
 
 	t.Fail()
 }
+
+func TestAdjacency_Iterators(t *testing.T) {
+	g := graph.NewAdjacency()
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+
+	nodes := slices.Sorted(g.Nodes())
+	assert.Equal(t, []int{0, 1, 2, 3}, nodes)
+
+	var edges [][2]int
+	for from, to := range g.Edges() {
+		edges = append(edges, [2]int{from, to})
+	}
+	assert.ElementsMatch(t, [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}}, edges)
+
+	assert.Equal(t, g.BFS(0), slices.Collect(g.WalkBFS(0)))
+	assert.Equal(t, g.DFSIterative(0), slices.Collect(g.WalkDFS(0)))
+
+	// Stopping early shouldn't walk the rest of the graph.
+	var visited []int
+	for node := range g.WalkBFS(0) {
+		visited = append(visited, node)
+		if len(visited) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 1}, visited)
+
+	assert.Empty(t, slices.Collect(g.WalkBFS(4)))
+}
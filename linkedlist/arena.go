@@ -0,0 +1,448 @@
+package linkedlist
+
+import (
+	"iter"
+	"math"
+	"slices"
+)
+
+// Handle refers to a node in an Arena. The zero Handle refers to no node, and is what Arena
+// returns where DoubleHeadTail would return a nil node.
+//
+// A Handle is only valid until its node is removed, after which the slot it refers to may be
+// reused by a later insert.
+type Handle int32
+
+type arenaSlot[T comparable] struct {
+	value      T
+	prev, next Handle
+}
+
+// Arena is a doubly linked list with the same operations as DoubleHeadTail, but its nodes live
+// in a single slice and link to each other by index rather than by pointer. That halves the
+// size of the links on 64-bit platforms, saves an allocation per node, and when T holds no
+// pointers leaves the garbage collector nothing to scan. Removed nodes go onto a free list to
+// be reused by later inserts. (An XOR linked list would save even more, but Go's garbage
+// collector can't follow pointers that have been XORed together.)
+//
+// Nodes are referred to by Handle rather than by *Node. The insert methods take values rather
+// than nodes, so the handle of a value that was just inserted at either end of the list can be
+// found with GetFirst or GetLast.
+//
+// Slot 0 is a sentinel that sits before the head and after the tail, which is why the zero
+// Handle refers to no node.
+type Arena[T comparable] struct {
+	slots []arenaSlot[T]
+	free  Handle
+	size  int
+}
+
+// NewArena returns an arena-backed linked list prepopulated with any values passed as
+// arguments (in order of parameter index).
+func NewArena[T comparable](values ...T) *Arena[T] {
+	var a Arena[T]
+	a.InsertLast(values...)
+	return &a
+}
+
+// Value returns the value of the node h refers to.
+func (a *Arena[T]) Value(h Handle) T {
+	return a.slots[h].value
+}
+
+// SetValue replaces the value of the node h refers to.
+func (a *Arena[T]) SetValue(h Handle, v T) {
+	a.slots[h].value = v
+}
+
+// Next returns the node after the one h refers to, or the zero Handle if h is the tail.
+func (a *Arena[T]) Next(h Handle) Handle {
+	return a.slots[h].next
+}
+
+// Prev returns the node before the one h refers to, or the zero Handle if h is the head.
+func (a *Arena[T]) Prev(h Handle) Handle {
+	return a.slots[h].prev
+}
+
+func (a *Arena[T]) Clear() {
+	// Zero the slots so that any pointers in the values can be collected.
+	clear(a.slots)
+	a.slots = a.slots[:0]
+	a.free = 0
+	a.size = 0
+}
+
+func (a *Arena[T]) Delete(target T) {
+	if h := a.Search(target); h != 0 {
+		a.Remove(h)
+	}
+}
+
+// Remove unlinks the node h refers to in constant time and frees its slot. h must refer to a
+// node in the list.
+func (a *Arena[T]) Remove(h Handle) {
+	a.unlink(h)
+	a.slots[h] = arenaSlot[T]{next: a.free}
+	a.free = h
+}
+
+// MoveToFront moves the node h refers to to the head of the list in constant time. h must
+// refer to a node in the list.
+func (a *Arena[T]) MoveToFront(h Handle) {
+	if a.GetFirst() == h {
+		return
+	}
+
+	a.unlink(h)
+	a.link(0, h)
+}
+
+// MoveToBack moves the node h refers to to the tail of the list in constant time. h must refer
+// to a node in the list.
+func (a *Arena[T]) MoveToBack(h Handle) {
+	if a.GetLast() == h {
+		return
+	}
+
+	a.unlink(h)
+	a.link(a.slots[0].prev, h)
+}
+
+// InsertBefore inserts values, in order, in front of mark in constant time per value. mark
+// must refer to a node in the list.
+func (a *Arena[T]) InsertBefore(mark Handle, values ...T) {
+	a.InsertAfterNode(a.slots[mark].prev, values...)
+}
+
+// InsertAfterNode inserts values, in order, behind mark in constant time per value. mark must
+// refer to a node in the list.
+func (a *Arena[T]) InsertAfterNode(mark Handle, values ...T) {
+	for i := range values {
+		h := a.alloc(values[i])
+		a.link(mark, h)
+		mark = h
+	}
+}
+
+func (a *Arena[T]) DeleteFirst() {
+	if a.size != 0 {
+		a.Remove(a.GetFirst())
+	}
+}
+
+func (a *Arena[T]) DeleteLast() {
+	if a.size != 0 {
+		a.Remove(a.GetLast())
+	}
+}
+
+func (a *Arena[T]) GetFirst() Handle {
+	if a.size == 0 {
+		return 0
+	}
+	return a.slots[0].next
+}
+
+func (a *Arena[T]) GetLast() Handle {
+	if a.size == 0 {
+		return 0
+	}
+	return a.slots[0].prev
+}
+
+func (a *Arena[T]) InsertAfter(target T, values ...T) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	h := a.Search(target)
+	if h == 0 {
+		return ErrTargetNoExist
+	}
+
+	a.InsertAfterNode(h, values...)
+	return nil
+}
+
+func (a *Arena[T]) InsertFirst(values ...T) {
+	for i := range values {
+		h := a.alloc(values[i])
+		a.link(0, h)
+	}
+}
+
+func (a *Arena[T]) InsertLast(values ...T) {
+	for i := range values {
+		h := a.alloc(values[i])
+		a.link(a.slots[0].prev, h)
+	}
+}
+
+func (a *Arena[T]) Search(target T) Handle {
+	for h := a.GetFirst(); h != 0; h = a.slots[h].next {
+		if a.slots[h].value == target {
+			return h
+		}
+	}
+	return 0
+}
+
+func (a *Arena[T]) Size() int {
+	return a.size
+}
+
+func (a *Arena[T]) ToArray() []T {
+	var res []T
+	for v := range a.All() {
+		res = append(res, v)
+	}
+	return res
+}
+
+// All returns an iterator over the values in the list from head to tail.
+func (a *Arena[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for h := a.GetFirst(); h != 0; h = a.slots[h].next {
+			if !yield(a.slots[h].value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values in the list from tail to head.
+func (a *Arena[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for h := a.GetLast(); h != 0; h = a.slots[h].prev {
+			if !yield(a.slots[h].value) {
+				return
+			}
+		}
+	}
+}
+
+// Reverse reverses the order of the list in place.
+func (a *Arena[T]) Reverse() {
+	if a.size == 0 {
+		return
+	}
+
+	// Swapping the links of every node, the sentinel included, reverses the whole ring. Once
+	// they are swapped, what was the next node is reached through prev.
+	h := Handle(0)
+	for {
+		s := &a.slots[h]
+		s.next, s.prev = s.prev, s.next
+		if h = s.prev; h == 0 {
+			return
+		}
+	}
+}
+
+// Sort stable sorts the list in place. cmp should return a negative number when a < b, a
+// positive number when a > b and zero when they are equal, like cmp.Compare.
+func (a *Arena[T]) Sort(cmp func(a, b T) int) {
+	if a.size == 0 {
+		return
+	}
+
+	handles := make([]Handle, 0, a.size)
+	for h := a.GetFirst(); h != 0; h = a.slots[h].next {
+		handles = append(handles, h)
+	}
+
+	slices.SortStableFunc(handles, func(x, y Handle) int {
+		return cmp(a.slots[x].value, a.slots[y].value)
+	})
+	a.relink(handles)
+}
+
+// Merge copies the values of other into the list, keeping it sorted. Both lists must already
+// be sorted by cmp. other is left empty. Since the two lists have separate arenas, the values
+// of other are copied rather than relinked.
+func (a *Arena[T]) Merge(other *Arena[T], cmp func(a, b T) int) {
+	cur := a.GetFirst()
+	for v := range other.All() {
+		// Values from other go after values in the list that compare equal to them.
+		for cur != 0 && cmp(v, a.slots[cur].value) >= 0 {
+			cur = a.slots[cur].next
+		}
+
+		if cur == 0 {
+			a.InsertLast(v)
+		} else {
+			a.InsertBefore(cur, v)
+		}
+	}
+	other.Clear()
+}
+
+// SplitAt leaves the values before index in the list and moves the rest into a new list.
+func (a *Arena[T]) SplitAt(index int) (*Arena[T], error) {
+	if index < 0 || index > a.size {
+		return nil, ErrIndexOutOfRange
+	}
+
+	rest := NewArena[T]()
+	if index == a.size {
+		return rest, nil
+	}
+
+	for h := a.nodeAt(index); h != 0; {
+		next := a.slots[h].next
+		rest.InsertLast(a.slots[h].value)
+		a.Remove(h)
+		h = next
+	}
+	return rest, nil
+}
+
+// Filter removes every node from the list whose value doesn't satisfy keep.
+func (a *Arena[T]) Filter(keep func(T) bool) {
+	for h := a.GetFirst(); h != 0; {
+		next := a.slots[h].next
+		if !keep(a.slots[h].value) {
+			a.Remove(h)
+		}
+		h = next
+	}
+}
+
+// Concat copies the values of other onto the end of the list, leaving other empty.
+func (a *Arena[T]) Concat(other *Arena[T]) {
+	for v := range other.All() {
+		a.InsertLast(v)
+	}
+	other.Clear()
+}
+
+// At returns the handle of the node at index, walking from whichever end of the list is
+// closer.
+func (a *Arena[T]) At(index int) (Handle, error) {
+	if index < 0 || index >= a.size {
+		return 0, ErrIndexOutOfRange
+	}
+	return a.nodeAt(index), nil
+}
+
+// InsertAt inserts values, in order, into the list so that the first of them ends up at
+// index. An index equal to the size of the list appends them.
+func (a *Arena[T]) InsertAt(index int, values ...T) error {
+	if index < 0 || index > a.size {
+		return ErrIndexOutOfRange
+	}
+
+	if index == a.size {
+		a.InsertLast(values...)
+		return nil
+	}
+
+	a.InsertBefore(a.nodeAt(index), values...)
+	return nil
+}
+
+// RemoveAt removes the node at index, walking from whichever end of the list is closer.
+func (a *Arena[T]) RemoveAt(index int) error {
+	if index < 0 || index >= a.size {
+		return ErrIndexOutOfRange
+	}
+
+	a.Remove(a.nodeAt(index))
+	return nil
+}
+
+// IndexOf returns the index of the first node holding target, or -1 if there isn't one.
+func (a *Arena[T]) IndexOf(target T) int {
+	i := 0
+	for h := a.GetFirst(); h != 0; h = a.slots[h].next {
+		if a.slots[h].value == target {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last node holding target, or -1 if there isn't one.
+// It walks backwards from the tail so it can stop at the first match.
+func (a *Arena[T]) LastIndexOf(target T) int {
+	i := a.size - 1
+	for h := a.GetLast(); h != 0; h = a.slots[h].prev {
+		if a.slots[h].value == target {
+			return i
+		}
+		i--
+	}
+	return -1
+}
+
+// nodeAt returns the handle of the node at index, which must be within the list, walking
+// from whichever end of the list is closer.
+func (a *Arena[T]) nodeAt(index int) Handle {
+	if index < a.size/2 {
+		h := a.GetFirst()
+		for i := 0; i < index; i++ {
+			h = a.slots[h].next
+		}
+		return h
+	}
+
+	h := a.GetLast()
+	for i := a.size - 1; i > index; i-- {
+		h = a.slots[h].prev
+	}
+	return h
+}
+
+// alloc stores v in a free slot, growing the arena if there isn't one, and returns its
+// handle. The slot isn't linked into the list.
+func (a *Arena[T]) alloc(v T) Handle {
+	if len(a.slots) == 0 {
+		// The sentinel links to itself while the list is empty, which its zero value does.
+		a.slots = append(a.slots, arenaSlot[T]{})
+	}
+
+	if a.free != 0 {
+		h := a.free
+		a.free = a.slots[h].next
+		a.slots[h] = arenaSlot[T]{value: v}
+		return h
+	}
+
+	if len(a.slots) > math.MaxInt32 {
+		panic("linkedlist: arena is full")
+	}
+
+	a.slots = append(a.slots, arenaSlot[T]{value: v})
+	return Handle(len(a.slots) - 1)
+}
+
+// link links h into the list after mark, which is the sentinel to link h in at the head.
+func (a *Arena[T]) link(mark, h Handle) {
+	next := a.slots[mark].next
+	a.slots[h].prev, a.slots[h].next = mark, next
+	a.slots[mark].next = h
+	a.slots[next].prev = h
+	a.size++
+}
+
+// unlink unlinks h from the list without freeing its slot.
+func (a *Arena[T]) unlink(h Handle) {
+	prev, next := a.slots[h].prev, a.slots[h].next
+	a.slots[prev].next = next
+	a.slots[next].prev = prev
+	a.size--
+}
+
+// relink links handles into the list in order, replacing whatever order it was in.
+func (a *Arena[T]) relink(handles []Handle) {
+	prev := Handle(0)
+	for _, h := range handles {
+		a.slots[prev].next = h
+		a.slots[h].prev = prev
+		prev = h
+	}
+	a.slots[prev].next = 0
+	a.slots[0].prev = prev
+}
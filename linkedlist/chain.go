@@ -0,0 +1,131 @@
+package linkedlist
+
+// The helpers in this file work on chains of nodes linked only through Next, which every
+// implementation has in common. Implementations that track more than the head are expected
+// to fix up their tail and Prev links afterwards.
+
+// reverseChain reverses the chain starting at head, returning the new head.
+func reverseChain[T any](head *Node[T]) *Node[T] {
+	var prev *Node[T]
+	for cur := head; cur != nil; {
+		next := cur.Next
+		cur.Next = prev
+		prev = cur
+		cur = next
+	}
+	return prev
+}
+
+// mergeChains merges the sorted chains a and b into one sorted chain, returning its head.
+// Nodes from a come before nodes from b that compare equal to them.
+func mergeChains[T any](a, b *Node[T], cmp func(a, b T) int) *Node[T] {
+	var sentinel Node[T]
+	tail := &sentinel
+
+	for a != nil && b != nil {
+		if cmp(b.Data, a.Data) < 0 {
+			tail.Next, b = b, b.Next
+		} else {
+			tail.Next, a = a, a.Next
+		}
+		tail = tail.Next
+	}
+
+	if a != nil {
+		tail.Next = a
+	} else {
+		tail.Next = b
+	}
+
+	return sentinel.Next
+}
+
+// sortChain stable sorts the chain starting at head using merge sort, returning the new head.
+func sortChain[T any](head *Node[T], cmp func(a, b T) int) *Node[T] {
+	if head == nil || head.Next == nil {
+		return head
+	}
+
+	// Find the end of the first half by moving fast twice as quickly as slow.
+	slow, fast := head, head.Next
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+
+	second := slow.Next
+	slow.Next = nil
+
+	return mergeChains(sortChain(head, cmp), sortChain(second, cmp), cmp)
+}
+
+// filterChain unlinks every node in the chain starting at head that doesn't satisfy keep,
+// returning the new head and tail and the number of nodes that were kept.
+func filterChain[T any](head *Node[T], keep func(T) bool) (*Node[T], *Node[T], int) {
+	var sentinel Node[T]
+	tail, kept := &sentinel, 0
+
+	for cur := head; cur != nil; cur = cur.Next {
+		if keep(cur.Data) {
+			tail.Next = cur
+			tail = cur
+			kept++
+		}
+	}
+	tail.Next = nil
+
+	if kept == 0 {
+		return nil, nil, 0
+	}
+	return sentinel.Next, tail, kept
+}
+
+// chainTail returns the last node in the chain starting at head.
+func chainTail[T any](head *Node[T]) *Node[T] {
+	if head == nil {
+		return nil
+	}
+
+	cur := head
+	for cur.Next != nil {
+		cur = cur.Next
+	}
+	return cur
+}
+
+// nodeAt returns the node index nodes along the chain starting at head. index must be
+// within the chain.
+func nodeAt[T any](head *Node[T], index int) *Node[T] {
+	cur := head
+	for i := 0; i < index; i++ {
+		cur = cur.Next
+	}
+	return cur
+}
+
+// indexOf returns how many nodes along the chain starting at head the first node holding
+// target is, or -1 if there isn't one within the first size nodes. Bounding the walk by size
+// lets circular lists use it too.
+func indexOf[T comparable](head *Node[T], size int, target T) int {
+	cur := head
+	for i := 0; i < size; i++ {
+		if cur.Data == target {
+			return i
+		}
+		cur = cur.Next
+	}
+	return -1
+}
+
+// lastIndexOf returns how many nodes along the chain starting at head the last node holding
+// target is, or -1 if there isn't one within the first size nodes.
+func lastIndexOf[T comparable](head *Node[T], size int, target T) int {
+	last, cur := -1, head
+	for i := 0; i < size; i++ {
+		if cur.Data == target {
+			last = i
+		}
+		cur = cur.Next
+	}
+	return last
+}
@@ -0,0 +1,247 @@
+package linkedlist
+
+import "iter"
+
+var _ LinkedList[int] = &CircularDoubly[int]{}
+
+// CircularDoubly is a doubly linked list whose tail links back around to its head, and whose
+// head links back around to its tail. Only the head is tracked, since the tail is always the
+// node before it.
+type CircularDoubly[T comparable] struct {
+	head *Node[T]
+	size int
+}
+
+// NewCircularDoubly returns a circular doubly linked list prepopulated with any nodes passed
+// as arguments (linked in order of parameter index).
+func NewCircularDoubly[T comparable](nodes ...*Node[T]) *CircularDoubly[T] {
+	var ll CircularDoubly[T]
+	ll.InsertLast(nodes...)
+	return &ll
+}
+
+func (ll *CircularDoubly[T]) GetFirst() *Node[T] {
+	return ll.head
+}
+
+func (ll *CircularDoubly[T]) GetLast() *Node[T] {
+	if ll.head == nil {
+		return nil
+	}
+	return ll.head.Prev
+}
+
+func (ll *CircularDoubly[T]) InsertFirst(nodes ...*Node[T]) {
+	for i := range nodes {
+		ll.link(ll.GetLast(), nodes[i])
+		ll.head = nodes[i]
+	}
+}
+
+func (ll *CircularDoubly[T]) InsertLast(nodes ...*Node[T]) {
+	for i := range nodes {
+		ll.link(ll.GetLast(), nodes[i])
+	}
+}
+
+func (ll *CircularDoubly[T]) InsertAfter(target T, nodes ...*Node[T]) error {
+	after := ll.Search(target)
+	if after == nil {
+		return ErrTargetNoExist
+	}
+
+	for i := range nodes {
+		ll.link(after, nodes[i])
+		after = nodes[i]
+	}
+	return nil
+}
+
+func (ll *CircularDoubly[T]) DeleteFirst() {
+	if ll.size != 0 {
+		ll.Remove(ll.head)
+	}
+}
+
+func (ll *CircularDoubly[T]) DeleteLast() {
+	if ll.size != 0 {
+		ll.Remove(ll.head.Prev)
+	}
+}
+
+func (ll *CircularDoubly[T]) Delete(target T) {
+	if n := ll.Search(target); n != nil {
+		ll.Remove(n)
+	}
+}
+
+// Remove unlinks n from the list in constant time. n must be a node in the list.
+func (ll *CircularDoubly[T]) Remove(n *Node[T]) {
+	if ll.size == 1 {
+		ll.Clear()
+		return
+	}
+
+	n.Prev.Next = n.Next
+	n.Next.Prev = n.Prev
+	if n == ll.head {
+		ll.head = n.Next
+	}
+	ll.size--
+}
+
+func (ll *CircularDoubly[T]) Size() int {
+	return ll.size
+}
+
+func (ll *CircularDoubly[T]) Search(target T) *Node[T] {
+	cur := ll.head
+	for i := 0; i < ll.size; i++ {
+		if cur.Data == target {
+			return cur
+		}
+		cur = cur.Next
+	}
+	return nil
+}
+
+func (ll *CircularDoubly[T]) Clear() {
+	ll.head = nil
+	ll.size = 0
+}
+
+func (ll *CircularDoubly[T]) ToArray() []T {
+	var res []T
+	for v := range ll.All() {
+		res = append(res, v)
+	}
+	return res
+}
+
+// All returns an iterator over the values in the list from head to tail, going around the
+// list once.
+func (ll *CircularDoubly[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		cur := ll.head
+		for i := 0; i < ll.size; i++ {
+			if !yield(cur.Data) {
+				return
+			}
+			cur = cur.Next
+		}
+	}
+}
+
+// At returns the node at index, walking around whichever way is shorter.
+func (ll *CircularDoubly[T]) At(index int) (*Node[T], error) {
+	if index < 0 || index >= ll.size {
+		return nil, ErrIndexOutOfRange
+	}
+	return ll.walk(ll.head, index), nil
+}
+
+// InsertAt links nodes, in order, into the list so that the first of them ends up at index.
+// An index equal to the size of the list appends them.
+func (ll *CircularDoubly[T]) InsertAt(index int, nodes ...*Node[T]) error {
+	if index < 0 || index > ll.size {
+		return ErrIndexOutOfRange
+	}
+
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	if index == ll.size {
+		ll.InsertLast(nodes...)
+		return nil
+	}
+
+	// Link the nodes in front of whichever node is currently at index.
+	after := ll.walk(ll.head, index).Prev
+	for i := range nodes {
+		ll.link(after, nodes[i])
+		after = nodes[i]
+	}
+
+	if index == 0 {
+		ll.head = nodes[0]
+	}
+	return nil
+}
+
+// RemoveAt unlinks the node at index, walking around whichever way is shorter.
+func (ll *CircularDoubly[T]) RemoveAt(index int) error {
+	if index < 0 || index >= ll.size {
+		return ErrIndexOutOfRange
+	}
+
+	ll.Remove(ll.walk(ll.head, index))
+	return nil
+}
+
+// IndexOf returns the index of the first node holding target, or -1 if there isn't one.
+func (ll *CircularDoubly[T]) IndexOf(target T) int {
+	return indexOf(ll.head, ll.size, target)
+}
+
+// LastIndexOf returns the index of the last node holding target, or -1 if there isn't one.
+// It walks backwards from the tail so it can stop at the first match.
+func (ll *CircularDoubly[T]) LastIndexOf(target T) int {
+	cur := ll.GetLast()
+	for i := ll.size - 1; i >= 0; i-- {
+		if cur.Data == target {
+			return i
+		}
+		cur = cur.Prev
+	}
+	return -1
+}
+
+// Rotate moves the head of the list n nodes forwards, so that the first n nodes end up at the
+// back of the list. A negative n rotates the list backwards instead.
+func (ll *CircularDoubly[T]) Rotate(n int) {
+	if ll.size == 0 {
+		return
+	}
+	ll.head = ll.walk(ll.head, ((n%ll.size)+ll.size)%ll.size)
+}
+
+// Cursor returns a cursor sitting on the head of the list.
+func (ll *CircularDoubly[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{
+		node: ll.head,
+		prev: func(n *Node[T]) *Node[T] {
+			return n.Prev
+		},
+	}
+}
+
+// walk returns the node steps nodes forwards from start, where steps is less than the size of
+// the list. It goes backwards instead when that is shorter.
+func (ll *CircularDoubly[T]) walk(start *Node[T], steps int) *Node[T] {
+	cur := start
+	if steps <= ll.size/2 {
+		for i := 0; i < steps; i++ {
+			cur = cur.Next
+		}
+		return cur
+	}
+
+	for i := 0; i < ll.size-steps; i++ {
+		cur = cur.Prev
+	}
+	return cur
+}
+
+// link links n into the list after prev, which is nil if the list is empty.
+func (ll *CircularDoubly[T]) link(prev, n *Node[T]) {
+	if prev == nil {
+		n.Next, n.Prev = n, n
+		ll.head = n
+	} else {
+		n.Prev, n.Next = prev, prev.Next
+		prev.Next.Prev = n
+		prev.Next = n
+	}
+	ll.size++
+}
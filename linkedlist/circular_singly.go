@@ -0,0 +1,246 @@
+package linkedlist
+
+import "iter"
+
+var _ LinkedList[int] = &CircularSingly[int]{}
+
+// CircularSingly is a singly linked list whose tail links back around to its head. Only the
+// tail is tracked, since the head is always the node after it.
+type CircularSingly[T comparable] struct {
+	tail *Node[T]
+	size int
+}
+
+// NewCircularSingly returns a circular singly linked list prepopulated with any nodes passed
+// as arguments (linked in order of parameter index).
+func NewCircularSingly[T comparable](nodes ...*Node[T]) *CircularSingly[T] {
+	var ll CircularSingly[T]
+	ll.InsertLast(nodes...)
+	return &ll
+}
+
+func (ll *CircularSingly[T]) GetFirst() *Node[T] {
+	if ll.tail == nil {
+		return nil
+	}
+	return ll.tail.Next
+}
+
+func (ll *CircularSingly[T]) GetLast() *Node[T] {
+	return ll.tail
+}
+
+func (ll *CircularSingly[T]) InsertFirst(nodes ...*Node[T]) {
+	for i := range nodes {
+		ll.link(ll.tail, nodes[i])
+	}
+}
+
+func (ll *CircularSingly[T]) InsertLast(nodes ...*Node[T]) {
+	for i := range nodes {
+		ll.link(ll.tail, nodes[i])
+		ll.tail = nodes[i]
+	}
+}
+
+func (ll *CircularSingly[T]) InsertAfter(target T, nodes ...*Node[T]) error {
+	after := ll.Search(target)
+	if after == nil {
+		return ErrTargetNoExist
+	}
+
+	ll.linkAfter(after, nodes...)
+	return nil
+}
+
+func (ll *CircularSingly[T]) DeleteFirst() {
+	if ll.size == 0 {
+		return
+	}
+	ll.unlink(ll.tail)
+}
+
+func (ll *CircularSingly[T]) DeleteLast() {
+	if ll.size == 0 {
+		return
+	}
+	ll.unlink(ll.before(ll.tail))
+}
+
+func (ll *CircularSingly[T]) Delete(target T) {
+	prev := ll.tail
+	for i := 0; i < ll.size; i++ {
+		if prev.Next.Data == target {
+			ll.unlink(prev)
+			return
+		}
+		prev = prev.Next
+	}
+}
+
+func (ll *CircularSingly[T]) Size() int {
+	return ll.size
+}
+
+func (ll *CircularSingly[T]) Search(target T) *Node[T] {
+	if idx := ll.IndexOf(target); idx != -1 {
+		return nodeAt(ll.GetFirst(), idx)
+	}
+	return nil
+}
+
+func (ll *CircularSingly[T]) Clear() {
+	ll.tail = nil
+	ll.size = 0
+}
+
+func (ll *CircularSingly[T]) ToArray() []T {
+	var res []T
+	for v := range ll.All() {
+		res = append(res, v)
+	}
+	return res
+}
+
+// All returns an iterator over the values in the list from head to tail, going around the
+// list once.
+func (ll *CircularSingly[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		cur := ll.GetFirst()
+		for i := 0; i < ll.size; i++ {
+			if !yield(cur.Data) {
+				return
+			}
+			cur = cur.Next
+		}
+	}
+}
+
+// At returns the node at index.
+func (ll *CircularSingly[T]) At(index int) (*Node[T], error) {
+	if index < 0 || index >= ll.size {
+		return nil, ErrIndexOutOfRange
+	}
+	return nodeAt(ll.GetFirst(), index), nil
+}
+
+// InsertAt links nodes, in order, into the list so that the first of them ends up at index.
+// An index equal to the size of the list appends them.
+func (ll *CircularSingly[T]) InsertAt(index int, nodes ...*Node[T]) error {
+	if index < 0 || index > ll.size {
+		return ErrIndexOutOfRange
+	}
+
+	switch index {
+	case ll.size:
+		ll.InsertLast(nodes...)
+	case 0:
+		// Linking after the tail puts the nodes at the front without moving the tail.
+		for i := len(nodes) - 1; i >= 0; i-- {
+			ll.InsertFirst(nodes[i])
+		}
+	default:
+		ll.linkAfter(nodeAt(ll.GetFirst(), index-1), nodes...)
+	}
+
+	return nil
+}
+
+// RemoveAt unlinks the node at index.
+func (ll *CircularSingly[T]) RemoveAt(index int) error {
+	if index < 0 || index >= ll.size {
+		return ErrIndexOutOfRange
+	}
+
+	// The node before the head is the tail.
+	prev := ll.tail
+	if index > 0 {
+		prev = nodeAt(ll.GetFirst(), index-1)
+	}
+	ll.unlink(prev)
+
+	return nil
+}
+
+// IndexOf returns the index of the first node holding target, or -1 if there isn't one.
+func (ll *CircularSingly[T]) IndexOf(target T) int {
+	return indexOf(ll.GetFirst(), ll.size, target)
+}
+
+// LastIndexOf returns the index of the last node holding target, or -1 if there isn't one.
+func (ll *CircularSingly[T]) LastIndexOf(target T) int {
+	return lastIndexOf(ll.GetFirst(), ll.size, target)
+}
+
+// Rotate moves the head of the list n nodes forwards, so that the first n nodes end up at the
+// back of the list. A negative n rotates the list backwards instead. Either way the list is
+// only walked forwards, so rotating backwards costs as much as rotating the rest of the way
+// around.
+func (ll *CircularSingly[T]) Rotate(n int) {
+	if ll.size == 0 {
+		return
+	}
+
+	n = ((n % ll.size) + ll.size) % ll.size
+	for i := 0; i < n; i++ {
+		ll.tail = ll.tail.Next
+	}
+}
+
+// Cursor returns a cursor sitting on the head of the list. Moving it backwards has to walk
+// all the way around the list, since nodes only link forwards.
+func (ll *CircularSingly[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{
+		node: ll.GetFirst(),
+		prev: ll.before,
+	}
+}
+
+// before returns the node that links to n by walking around the list.
+func (ll *CircularSingly[T]) before(n *Node[T]) *Node[T] {
+	cur := n
+	for cur.Next != n {
+		cur = cur.Next
+	}
+	return cur
+}
+
+// link links n into the list after prev, which is nil if the list is empty.
+func (ll *CircularSingly[T]) link(prev, n *Node[T]) {
+	if prev == nil {
+		n.Next = n
+		ll.tail = n
+	} else {
+		n.Next = prev.Next
+		prev.Next = n
+	}
+	ll.size++
+}
+
+// linkAfter links nodes, in order, after prev, moving the tail if prev was the tail.
+func (ll *CircularSingly[T]) linkAfter(prev *Node[T], nodes ...*Node[T]) {
+	wasTail := prev == ll.tail
+	for i := range nodes {
+		ll.link(prev, nodes[i])
+		prev = nodes[i]
+	}
+
+	if wasTail {
+		ll.tail = prev
+	}
+}
+
+// unlink unlinks the node after prev.
+func (ll *CircularSingly[T]) unlink(prev *Node[T]) {
+	if ll.size == 1 {
+		ll.Clear()
+		return
+	}
+
+	n := prev.Next
+	prev.Next = n.Next
+	if n == ll.tail {
+		ll.tail = prev
+	}
+	ll.size--
+}
@@ -0,0 +1,32 @@
+package linkedlist
+
+// Cursor walks around a circular list, forwards or backwards, forever.
+//
+// A cursor sits on a node rather than an index, so it stays where it is as nodes elsewhere
+// in the list come and go. It must not be used once the node it sits on has been removed.
+type Cursor[T comparable] struct {
+	node *Node[T]
+	prev func(n *Node[T]) *Node[T]
+}
+
+// Node returns the node the cursor is on, which is nil if the list was empty when the cursor
+// was created.
+func (c *Cursor[T]) Node() *Node[T] {
+	return c.node
+}
+
+// Next moves the cursor forwards one node and returns that node.
+func (c *Cursor[T]) Next() *Node[T] {
+	if c.node != nil {
+		c.node = c.node.Next
+	}
+	return c.node
+}
+
+// Prev moves the cursor backwards one node and returns that node.
+func (c *Cursor[T]) Prev() *Node[T] {
+	if c.node != nil {
+		c.node = c.prev(c.node)
+	}
+	return c.node
+}
@@ -1,5 +1,7 @@
 package linkedlist
 
+import "iter"
+
 var _ LinkedList[int] = &DoubleHeadTail[int]{}
 
 type DoubleHeadTail[T comparable] struct {
@@ -20,13 +22,16 @@ func (d *DoubleHeadTail[T]) Clear() {
 }
 
 func (d *DoubleHeadTail[T]) Delete(target T) {
-	n := d.Search(target)
-	if n == nil {
-		return
+	if n := d.Search(target); n != nil {
+		d.Remove(n)
 	}
+}
 
+// Remove unlinks n from the list in constant time. n must be a node in the list.
+func (d *DoubleHeadTail[T]) Remove(n *Node[T]) {
 	if d.size == 1 {
 		d.Clear()
+		n.Next, n.Prev = nil, nil
 		return
 	}
 
@@ -49,9 +54,72 @@ func (d *DoubleHeadTail[T]) Delete(target T) {
 		d.tail.Next = nil
 	}
 
+	n.Next, n.Prev = nil, nil
 	d.size--
 }
 
+// MoveToFront moves n to the head of the list in constant time. n must be a node in the list.
+func (d *DoubleHeadTail[T]) MoveToFront(n *Node[T]) {
+	if d.head == n {
+		return
+	}
+
+	d.Remove(n)
+	d.InsertFirst(n)
+}
+
+// MoveToBack moves n to the tail of the list in constant time. n must be a node in the list.
+func (d *DoubleHeadTail[T]) MoveToBack(n *Node[T]) {
+	if d.tail == n {
+		return
+	}
+
+	d.Remove(n)
+	d.InsertLast(n)
+}
+
+// InsertBefore links nodes, in order, in front of mark in constant time per node. mark must
+// be a node in the list.
+func (d *DoubleHeadTail[T]) InsertBefore(mark *Node[T], nodes ...*Node[T]) {
+	if len(nodes) == 0 {
+		return
+	}
+
+	if mark.Prev == nil {
+		// mark is the head, so walk backwards pushing each node onto the front to keep
+		// them in order.
+		for i := len(nodes) - 1; i >= 0; i-- {
+			d.InsertFirst(nodes[i])
+		}
+		return
+	}
+
+	d.InsertAfterNode(mark.Prev, nodes...)
+}
+
+// InsertAfterNode links nodes, in order, behind mark in constant time per node. mark must be
+// a node in the list.
+func (d *DoubleHeadTail[T]) InsertAfterNode(mark *Node[T], nodes ...*Node[T]) {
+	if len(nodes) == 0 {
+		return
+	}
+
+	last := mark.Next
+	for i := range nodes {
+		mark.Next = nodes[i]
+		nodes[i].Prev = mark
+		mark = nodes[i]
+		d.size++
+	}
+
+	mark.Next = last
+	if last != nil {
+		last.Prev = mark
+	} else {
+		d.tail = mark
+	}
+}
+
 func (d *DoubleHeadTail[T]) DeleteFirst() {
 	if d.head == nil {
 		return
@@ -100,19 +168,7 @@ func (d *DoubleHeadTail[T]) InsertAfter(target T, nodes ...*Node[T]) error {
 		return ErrTargetNoExist
 	}
 
-	last := n.Next
-	for i := range nodes {
-		n.Next = nodes[i]
-		nodes[i].Prev = n
-		n = nodes[i]
-		d.size++
-	}
-
-	nodes[len(nodes)-1].Next = last
-	if last != nil {
-		last.Prev = nodes[len(nodes)-1]
-	}
-
+	d.InsertAfterNode(n, nodes...)
 	return nil
 }
 
@@ -176,3 +232,182 @@ func (d *DoubleHeadTail[T]) ToArray() []T {
 	}
 	return res
 }
+
+// All returns an iterator over the values in the list from head to tail.
+func (d *DoubleHeadTail[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for cur := d.head; cur != nil; cur = cur.Next {
+			if !yield(cur.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values in the list from tail to head.
+func (d *DoubleHeadTail[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for cur := d.tail; cur != nil; cur = cur.Prev {
+			if !yield(cur.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Reverse reverses the order of the list in place.
+func (d *DoubleHeadTail[T]) Reverse() {
+	for cur := d.head; cur != nil; cur = cur.Prev {
+		// Once the links are swapped, what was the next node is reached through Prev.
+		cur.Next, cur.Prev = cur.Prev, cur.Next
+	}
+	d.head, d.tail = d.tail, d.head
+}
+
+// Sort stable sorts the list in place. cmp should return a negative number when a < b, a
+// positive number when a > b and zero when they are equal, like cmp.Compare.
+func (d *DoubleHeadTail[T]) Sort(cmp func(a, b T) int) {
+	d.head = sortChain(d.head, cmp)
+	d.relink()
+}
+
+// Merge moves the nodes of other into the list, keeping it sorted. Both lists must already be
+// sorted by cmp. other is left empty.
+func (d *DoubleHeadTail[T]) Merge(other *DoubleHeadTail[T], cmp func(a, b T) int) {
+	d.head = mergeChains(d.head, other.head, cmp)
+	d.size += other.size
+	d.relink()
+	other.Clear()
+}
+
+// SplitAt leaves the nodes before index in the list and moves the rest into a new list.
+func (d *DoubleHeadTail[T]) SplitAt(index int) (*DoubleHeadTail[T], error) {
+	if index < 0 || index > d.size {
+		return nil, ErrIndexOutOfRange
+	}
+
+	if index == 0 {
+		rest := *d
+		d.Clear()
+		return &rest, nil
+	}
+
+	if index == d.size {
+		return NewDoubleHeadTail[T](), nil
+	}
+
+	last := nodeAt(d.head, index-1)
+	rest := DoubleHeadTail[T]{
+		head: last.Next,
+		tail: d.tail,
+		size: d.size - index,
+	}
+
+	rest.head.Prev = nil
+	last.Next = nil
+	d.tail = last
+	d.size = index
+
+	return &rest, nil
+}
+
+// Filter removes every node from the list whose value doesn't satisfy keep.
+func (d *DoubleHeadTail[T]) Filter(keep func(T) bool) {
+	d.head, _, d.size = filterChain(d.head, keep)
+	d.relink()
+}
+
+// Concat moves the nodes of other onto the end of the list, leaving other empty.
+func (d *DoubleHeadTail[T]) Concat(other *DoubleHeadTail[T]) {
+	if other.size == 0 {
+		return
+	}
+
+	if d.size == 0 {
+		d.head = other.head
+	} else {
+		d.tail.Next = other.head
+		other.head.Prev = d.tail
+	}
+
+	d.tail = other.tail
+	d.size += other.size
+	other.Clear()
+}
+
+// relink rebuilds the Prev links and the tail of the list after its nodes have been
+// rearranged through their Next links alone.
+func (d *DoubleHeadTail[T]) relink() {
+	var prev *Node[T]
+	for cur := d.head; cur != nil; cur = cur.Next {
+		cur.Prev = prev
+		prev = cur
+	}
+	d.tail = prev
+}
+
+// At returns the node at index, walking from whichever end of the list is closer.
+func (d *DoubleHeadTail[T]) At(index int) (*Node[T], error) {
+	if index < 0 || index >= d.size {
+		return nil, ErrIndexOutOfRange
+	}
+	return d.nodeAt(index), nil
+}
+
+// InsertAt links nodes, in order, into the list so that the first of them ends up at index.
+// An index equal to the size of the list appends them.
+func (d *DoubleHeadTail[T]) InsertAt(index int, nodes ...*Node[T]) error {
+	if index < 0 || index > d.size {
+		return ErrIndexOutOfRange
+	}
+
+	if index == d.size {
+		d.InsertLast(nodes...)
+		return nil
+	}
+
+	d.InsertBefore(d.nodeAt(index), nodes...)
+	return nil
+}
+
+// RemoveAt unlinks the node at index, walking from whichever end of the list is closer.
+func (d *DoubleHeadTail[T]) RemoveAt(index int) error {
+	if index < 0 || index >= d.size {
+		return ErrIndexOutOfRange
+	}
+
+	d.Remove(d.nodeAt(index))
+	return nil
+}
+
+// IndexOf returns the index of the first node holding target, or -1 if there isn't one.
+func (d *DoubleHeadTail[T]) IndexOf(target T) int {
+	return indexOf(d.head, d.size, target)
+}
+
+// LastIndexOf returns the index of the last node holding target, or -1 if there isn't one.
+// It walks backwards from the tail so it can stop at the first match.
+func (d *DoubleHeadTail[T]) LastIndexOf(target T) int {
+	i := d.size - 1
+	for cur := d.tail; cur != nil; cur = cur.Prev {
+		if cur.Data == target {
+			return i
+		}
+		i--
+	}
+	return -1
+}
+
+// nodeAt returns the node at index, which must be within the list, walking from whichever
+// end of the list is closer.
+func (d *DoubleHeadTail[T]) nodeAt(index int) *Node[T] {
+	if index < d.size/2 {
+		return nodeAt(d.head, index)
+	}
+
+	cur := d.tail
+	for i := d.size - 1; i > index; i-- {
+		cur = cur.Prev
+	}
+	return cur
+}
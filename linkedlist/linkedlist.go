@@ -1,9 +1,15 @@
 package linkedlist
 
-import "errors"
+import (
+	"errors"
+	"iter"
+)
 
 var ErrTargetNoExist = errors.New("target does not exist")
 
+// ErrIndexOutOfRange denotes that an index was outside of the bounds of a list.
+var ErrIndexOutOfRange = errors.New("index out of range")
+
 // LinkedList is the interface that all linked list implementation should implement.
 type LinkedList[T comparable] interface {
 	GetFirst() *Node[T]
@@ -18,6 +24,12 @@ type LinkedList[T comparable] interface {
 	Search(target T) *Node[T]
 	Clear()
 	ToArray() []T
+	All() iter.Seq[T]
+	At(index int) (*Node[T], error)
+	InsertAt(index int, nodes ...*Node[T]) error
+	RemoveAt(index int) error
+	IndexOf(target T) int
+	LastIndexOf(target T) int
 }
 
 var _ LinkedList[int] = &Classic[int]{}
@@ -108,15 +120,15 @@ func (ll *Classic[T]) Delete(target T) {
 		return
 	}
 
-	prev := ll.head
-	if prev.Data == target {
-		ll.head = prev.Next
+	if ll.head.Data == target {
+		ll.head = ll.head.Next
 		ll.size--
+		return
 	}
 
-	for cur := prev.Next; cur != nil; cur = cur.Next {
-		if cur.Data == target {
-			prev.Next = cur.Next
+	for prev := ll.head; prev.Next != nil; prev = prev.Next {
+		if prev.Next.Data == target {
+			prev.Next = prev.Next.Next
 			ll.size--
 			return
 		}
@@ -162,3 +174,141 @@ func (ll *Classic[T]) ToArray() []T {
 	}
 	return res
 }
+
+// All returns an iterator over the values in the list from head to tail.
+func (ll *Classic[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for cur := ll.head; cur != nil; cur = cur.Next {
+			if !yield(cur.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Reverse reverses the order of the list in place.
+func (ll *Classic[T]) Reverse() {
+	ll.head = reverseChain(ll.head)
+}
+
+// Sort stable sorts the list in place. cmp should return a negative number when a < b, a
+// positive number when a > b and zero when they are equal, like cmp.Compare.
+func (ll *Classic[T]) Sort(cmp func(a, b T) int) {
+	ll.head = sortChain(ll.head, cmp)
+}
+
+// Merge moves the nodes of other into the list, keeping it sorted. Both lists must already be
+// sorted by cmp. other is left empty.
+func (ll *Classic[T]) Merge(other *Classic[T], cmp func(a, b T) int) {
+	ll.head = mergeChains(ll.head, other.head, cmp)
+	ll.size += other.size
+	other.Clear()
+}
+
+// SplitAt leaves the nodes before index in the list and moves the rest into a new list.
+func (ll *Classic[T]) SplitAt(index int) (*Classic[T], error) {
+	if index < 0 || index > ll.size {
+		return nil, ErrIndexOutOfRange
+	}
+
+	rest := Classic[T]{
+		size: ll.size - index,
+	}
+
+	if index == 0 {
+		rest.head = ll.head
+		ll.Clear()
+		return &rest, nil
+	}
+
+	last := nodeAt(ll.head, index-1)
+	rest.head = last.Next
+	last.Next = nil
+	ll.size = index
+
+	return &rest, nil
+}
+
+// Filter removes every node from the list whose value doesn't satisfy keep.
+func (ll *Classic[T]) Filter(keep func(T) bool) {
+	ll.head, _, ll.size = filterChain(ll.head, keep)
+}
+
+// Concat moves the nodes of other onto the end of the list, leaving other empty.
+func (ll *Classic[T]) Concat(other *Classic[T]) {
+	if other.head == nil {
+		return
+	}
+
+	if ll.head == nil {
+		ll.head = other.head
+	} else {
+		chainTail(ll.head).Next = other.head
+	}
+
+	ll.size += other.size
+	other.Clear()
+}
+
+// At returns the node at index.
+func (ll *Classic[T]) At(index int) (*Node[T], error) {
+	if index < 0 || index >= ll.size {
+		return nil, ErrIndexOutOfRange
+	}
+	return nodeAt(ll.head, index), nil
+}
+
+// InsertAt links nodes, in order, into the list so that the first of them ends up at index.
+// An index equal to the size of the list appends them.
+func (ll *Classic[T]) InsertAt(index int, nodes ...*Node[T]) error {
+	if index < 0 || index > ll.size {
+		return ErrIndexOutOfRange
+	}
+
+	if index == 0 {
+		// InsertFirst pushes each node in front of the last, so go backwards to keep them in
+		// order.
+		for i := len(nodes) - 1; i >= 0; i-- {
+			ll.InsertFirst(nodes[i])
+		}
+		return nil
+	}
+
+	after := nodeAt(ll.head, index-1)
+	for i := range nodes {
+		nodes[i].Next = after.Next
+		after.Next = nodes[i]
+		after = nodes[i]
+		ll.size++
+	}
+
+	return nil
+}
+
+// RemoveAt unlinks the node at index.
+func (ll *Classic[T]) RemoveAt(index int) error {
+	if index < 0 || index >= ll.size {
+		return ErrIndexOutOfRange
+	}
+
+	if index == 0 {
+		ll.DeleteFirst()
+		return nil
+	}
+
+	prev := nodeAt(ll.head, index-1)
+	prev.Next = prev.Next.Next
+	ll.size--
+
+	return nil
+}
+
+// IndexOf returns the index of the first node holding target, or -1 if there isn't one.
+func (ll *Classic[T]) IndexOf(target T) int {
+	return indexOf(ll.head, ll.size, target)
+}
+
+// LastIndexOf returns the index of the last node holding target, or -1 if there isn't one.
+func (ll *Classic[T]) LastIndexOf(target T) int {
+	return lastIndexOf(ll.head, ll.size, target)
+}
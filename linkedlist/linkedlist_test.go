@@ -1,6 +1,11 @@
 package linkedlist_test
 
 import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/george-e-shaw-iv/go/linkedlist"
@@ -24,6 +29,18 @@ func TestLinkedList(t *testing.T) {
 			Name:           "DoubleLinkedTrackHeadAndTail",
 			Implementation: linkedlist.NewDoubleHeadTail[int](),
 		},
+		{
+			Name:           "CircularSingly",
+			Implementation: linkedlist.NewCircularSingly[int](),
+		},
+		{
+			Name:           "CircularDoubly",
+			Implementation: linkedlist.NewCircularDoubly[int](),
+		},
+		{
+			Name:           "Synchronized",
+			Implementation: linkedlist.NewSynchronized[int](linkedlist.NewDoubleHeadTail[int]()),
+		},
 	}
 
 	for _, test := range tests {
@@ -166,6 +183,822 @@ func TestLinkedList(t *testing.T) {
 				test.Implementation.Delete(3)
 				assert.Zero(t, len(test.Implementation.ToArray()))
 			})
+
+			t.Run("IndexBasedAccess", func(t *testing.T) {
+				t.Cleanup(test.Implementation.Clear)
+
+				// Inserting at the size of an empty list is the same as inserting at the front.
+				assert.NoError(t, test.Implementation.InsertAt(0, linkedlist.NewNode(2), linkedlist.NewNode(4)))
+				assert.NoError(t, test.Implementation.InsertAt(0, linkedlist.NewNode(0), linkedlist.NewNode(1)))
+				assert.NoError(t, test.Implementation.InsertAt(3, linkedlist.NewNode(3)))
+				assert.NoError(t, test.Implementation.InsertAt(5, linkedlist.NewNode(5), linkedlist.NewNode(2)))
+				assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 2}, test.Implementation.ToArray())
+				assert.Equal(t, 7, test.Implementation.Size())
+				assert.Equal(t, 2, test.Implementation.GetLast().Data)
+
+				// Inserting no nodes anywhere should leave the list as it was.
+				assert.NoError(t, test.Implementation.InsertAt(0))
+				assert.NoError(t, test.Implementation.InsertAt(3))
+				assert.NoError(t, test.Implementation.InsertAt(7))
+				assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 2}, test.Implementation.ToArray())
+
+				assert.ErrorIs(t, test.Implementation.InsertAt(8, linkedlist.NewNode(8)), linkedlist.ErrIndexOutOfRange)
+				assert.ErrorIs(t, test.Implementation.InsertAt(-1, linkedlist.NewNode(8)), linkedlist.ErrIndexOutOfRange)
+
+				for i, expected := range []int{0, 1, 2, 3, 4, 5, 2} {
+					n, err := test.Implementation.At(i)
+					assert.NoError(t, err)
+					assert.Equal(t, expected, n.Data)
+				}
+
+				_, err := test.Implementation.At(7)
+				assert.ErrorIs(t, err, linkedlist.ErrIndexOutOfRange)
+				_, err = test.Implementation.At(-1)
+				assert.ErrorIs(t, err, linkedlist.ErrIndexOutOfRange)
+
+				assert.Equal(t, 2, test.Implementation.IndexOf(2))
+				assert.Equal(t, 6, test.Implementation.LastIndexOf(2))
+				assert.Equal(t, 0, test.Implementation.LastIndexOf(0))
+				assert.Equal(t, -1, test.Implementation.IndexOf(9))
+				assert.Equal(t, -1, test.Implementation.LastIndexOf(9))
+
+				// Remove the last, first and a middle node.
+				assert.NoError(t, test.Implementation.RemoveAt(6))
+				assert.NoError(t, test.Implementation.RemoveAt(0))
+				assert.NoError(t, test.Implementation.RemoveAt(2))
+				assert.Equal(t, []int{1, 2, 4, 5}, test.Implementation.ToArray())
+				assert.Equal(t, 4, test.Implementation.Size())
+				assert.Equal(t, 5, test.Implementation.GetLast().Data)
+
+				assert.ErrorIs(t, test.Implementation.RemoveAt(4), linkedlist.ErrIndexOutOfRange)
+
+				// The tail should still be linked correctly after removing the last node.
+				test.Implementation.InsertLast(linkedlist.NewNode(6))
+				assert.Equal(t, []int{1, 2, 4, 5, 6}, test.Implementation.ToArray())
+			})
+
+			// Index-based access relies on the size and tail being kept right by every other
+			// operation, so mix the two.
+			t.Run("MixedValueAndIndexBasedAccess", func(t *testing.T) {
+				t.Cleanup(test.Implementation.Clear)
+
+				test.Implementation.InsertLast(nodes(1, 2, 3, 4)...)
+
+				// Delete 3 from the middle.
+				test.Implementation.Delete(3)
+				assert.Equal(t, []int{1, 2, 4}, test.Implementation.ToArray())
+				assert.Equal(t, 3, test.Implementation.Size())
+				assert.NoError(t, test.Implementation.RemoveAt(2))
+				assert.Equal(t, []int{1, 2}, test.Implementation.ToArray())
+
+				// Insert several nodes after the tail.
+				assert.NoError(t, test.Implementation.InsertAfter(2, nodes(3, 4)...))
+				assert.Equal(t, 4, test.Implementation.Size())
+				assert.Equal(t, 4, test.Implementation.GetLast().Data)
+				last, err := test.Implementation.At(test.Implementation.Size() - 1)
+				assert.NoError(t, err)
+				assert.Equal(t, 4, last.Data)
+
+				// Delete the tail by value, then append at its index.
+				test.Implementation.Delete(4)
+				assert.Equal(t, 3, test.Implementation.GetLast().Data)
+				assert.NoError(t, test.Implementation.InsertAt(test.Implementation.Size(), linkedlist.NewNode(5)))
+				assert.Equal(t, []int{1, 2, 3, 5}, test.Implementation.ToArray())
+				assert.Equal(t, 5, test.Implementation.GetLast().Data)
+				assert.Equal(t, 3, test.Implementation.IndexOf(5))
+				assert.Equal(t, 3, test.Implementation.LastIndexOf(5))
+			})
+
+			t.Run("All", func(t *testing.T) {
+				t.Cleanup(test.Implementation.Clear)
+
+				test.Implementation.InsertLast(linkedlist.NewNode(1), linkedlist.NewNode(2), linkedlist.NewNode(3), linkedlist.NewNode(4))
+				assert.Equal(t, []int{1, 2, 3, 4}, slices.Collect(test.Implementation.All()))
+
+				// Stopping early shouldn't visit the rest of the list.
+				var visited []int
+				for v := range test.Implementation.All() {
+					visited = append(visited, v)
+					if v == 2 {
+						break
+					}
+				}
+				assert.Equal(t, []int{1, 2}, visited)
+			})
 		})
 	}
 }
+
+func TestDoubleHeadTail_Backward(t *testing.T) {
+	ll := linkedlist.NewDoubleHeadTail(linkedlist.NewNode(1), linkedlist.NewNode(2), linkedlist.NewNode(3))
+	assert.Equal(t, []int{3, 2, 1}, slices.Collect(ll.Backward()))
+}
+
+func TestDoubleHeadTail_NodeOperations(t *testing.T) {
+	one, two, three := linkedlist.NewNode(1), linkedlist.NewNode(2), linkedlist.NewNode(3)
+	ll := linkedlist.NewDoubleHeadTail(one, two, three)
+
+	ll.MoveToFront(three)
+	assert.Equal(t, []int{3, 1, 2}, ll.ToArray())
+	assert.Equal(t, 3, ll.GetFirst().Data)
+	assert.Equal(t, 2, ll.GetLast().Data)
+
+	ll.MoveToBack(three)
+	assert.Equal(t, []int{1, 2, 3}, ll.ToArray())
+	assert.Equal(t, 3, ll.GetLast().Data)
+
+	// Inserting around the head and tail should move them.
+	zero, four := linkedlist.NewNode(0), linkedlist.NewNode(4)
+	ll.InsertBefore(one, linkedlist.NewNode(-1), zero)
+	ll.InsertAfterNode(three, four)
+	assert.Equal(t, []int{-1, 0, 1, 2, 3, 4}, ll.ToArray())
+	assert.Equal(t, -1, ll.GetFirst().Data)
+	assert.Equal(t, 4, ll.GetLast().Data)
+	assert.Equal(t, []int{4, 3, 2, 1, 0, -1}, slices.Collect(ll.Backward()))
+
+	ll.InsertBefore(two, linkedlist.NewNode(5), linkedlist.NewNode(6))
+	ll.InsertAfterNode(two, linkedlist.NewNode(7))
+	assert.Equal(t, []int{-1, 0, 1, 5, 6, 2, 7, 3, 4}, ll.ToArray())
+	assert.Equal(t, 9, ll.Size())
+
+	// Removing by node should remove that exact node, even when other nodes hold the same
+	// value.
+	dup := linkedlist.NewNode(1)
+	ll.InsertLast(dup)
+	ll.Remove(dup)
+	ll.Remove(ll.GetFirst())
+	ll.Remove(four)
+	assert.Equal(t, []int{0, 1, 5, 6, 2, 7, 3}, ll.ToArray())
+	assert.Equal(t, []int{3, 7, 2, 6, 5, 1, 0}, slices.Collect(ll.Backward()))
+	assert.Equal(t, 7, ll.Size())
+}
+
+// circular is implemented by every circular linked list.
+type circular interface {
+	linkedlist.LinkedList[int]
+	Rotate(n int)
+	Cursor() *linkedlist.Cursor[int]
+}
+
+func TestCircular(t *testing.T) {
+	tests := []struct {
+		Name string
+		New  func(nodes ...*linkedlist.Node[int]) circular
+	}{
+		{
+			Name: "CircularSingly",
+			New: func(nodes ...*linkedlist.Node[int]) circular {
+				return linkedlist.NewCircularSingly(nodes...)
+			},
+		},
+		{
+			Name: "CircularDoubly",
+			New: func(nodes ...*linkedlist.Node[int]) circular {
+				return linkedlist.NewCircularDoubly(nodes...)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			t.Run("Wraps", func(t *testing.T) {
+				ll := test.New(nodes(1, 2, 3)...)
+				assert.Equal(t, ll.GetFirst(), ll.GetLast().Next)
+
+				ll.DeleteFirst()
+				ll.InsertLast(linkedlist.NewNode(4))
+				assert.Equal(t, []int{2, 3, 4}, ll.ToArray())
+				assert.Equal(t, ll.GetFirst(), ll.GetLast().Next)
+
+				ll.Delete(5)
+				ll.Delete(4)
+				assert.Equal(t, []int{2, 3}, ll.ToArray())
+				assert.Equal(t, ll.GetFirst(), ll.GetLast().Next)
+			})
+
+			t.Run("Rotate", func(t *testing.T) {
+				ll := test.New()
+				ll.Rotate(3)
+				assert.Empty(t, ll.ToArray())
+
+				ll.InsertLast(nodes(1, 2, 3, 4)...)
+
+				ll.Rotate(1)
+				assert.Equal(t, []int{2, 3, 4, 1}, ll.ToArray())
+				assert.Equal(t, 1, ll.GetLast().Data)
+
+				ll.Rotate(-2)
+				assert.Equal(t, []int{4, 1, 2, 3}, ll.ToArray())
+
+				ll.Rotate(9)
+				assert.Equal(t, []int{1, 2, 3, 4}, ll.ToArray())
+				assert.Equal(t, 4, ll.Size())
+			})
+
+			t.Run("Cursor", func(t *testing.T) {
+				assert.Nil(t, test.New().Cursor().Next())
+
+				ll := test.New(nodes(1, 2, 3)...)
+				c := ll.Cursor()
+				assert.Equal(t, 1, c.Node().Data)
+
+				var forward []int
+				for i := 0; i < 7; i++ {
+					forward = append(forward, c.Next().Data)
+				}
+				assert.Equal(t, []int{2, 3, 1, 2, 3, 1, 2}, forward)
+
+				var backward []int
+				for i := 0; i < 7; i++ {
+					backward = append(backward, c.Prev().Data)
+				}
+				assert.Equal(t, []int{1, 3, 2, 1, 3, 2, 1}, backward)
+
+				// The cursor stays on its node as the list changes around it.
+				ll.InsertFirst(linkedlist.NewNode(0))
+				ll.Delete(2)
+				assert.Equal(t, 1, c.Node().Data)
+				assert.Equal(t, 3, c.Next().Data)
+				assert.Equal(t, 0, c.Next().Data)
+			})
+		})
+	}
+}
+
+func TestCircularDoubly_Remove(t *testing.T) {
+	one, two, three := linkedlist.NewNode(1), linkedlist.NewNode(2), linkedlist.NewNode(3)
+	ll := linkedlist.NewCircularDoubly(one, two, three)
+
+	ll.Remove(one)
+	assert.Equal(t, []int{2, 3}, ll.ToArray())
+	assert.Equal(t, three, two.Prev)
+	assert.Equal(t, two, three.Next)
+
+	ll.Remove(three)
+	ll.Remove(two)
+	assert.Equal(t, 0, ll.Size())
+	assert.Nil(t, ll.GetFirst())
+}
+
+// functional is implemented by every linked list with the in-place functional operations.
+type functional[L any] interface {
+	linkedlist.LinkedList[int]
+	Reverse()
+	Sort(cmp func(a, b int) int)
+	Merge(other L, cmp func(a, b int) int)
+	SplitAt(index int) (L, error)
+	Filter(keep func(int) bool)
+	Concat(other L)
+}
+
+func nodes(values ...int) []*linkedlist.Node[int] {
+	res := make([]*linkedlist.Node[int], len(values))
+	for i := range values {
+		res[i] = linkedlist.NewNode(values[i])
+	}
+	return res
+}
+
+func testFunctional[L functional[L]](t *testing.T, newList func(nodes ...*linkedlist.Node[int]) L) {
+	// assertList checks the contents of ll along with its size and tail, which the operations
+	// have to keep up to date alongside the links.
+	assertList := func(t *testing.T, expected []int, ll L) {
+		t.Helper()
+
+		assert.Equal(t, expected, ll.ToArray())
+		assert.Equal(t, len(expected), ll.Size())
+		if len(expected) == 0 {
+			assert.Nil(t, ll.GetFirst())
+			assert.Nil(t, ll.GetLast())
+			return
+		}
+		assert.Equal(t, expected[len(expected)-1], ll.GetLast().Data)
+	}
+
+	t.Run("Reverse", func(t *testing.T) {
+		ll := newList(nodes(1, 2, 3)...)
+		ll.Reverse()
+		assertList(t, []int{3, 2, 1}, ll)
+
+		// The list should still be usable from both ends afterwards.
+		ll.InsertLast(linkedlist.NewNode(0))
+		ll.InsertFirst(linkedlist.NewNode(4))
+		assertList(t, []int{4, 3, 2, 1, 0}, ll)
+
+		empty := newList()
+		empty.Reverse()
+		assertList(t, nil, empty)
+	})
+
+	t.Run("Sort", func(t *testing.T) {
+		ll := newList(nodes(5, 3, 4, 1, 2, 3)...)
+		ll.Sort(cmp.Compare[int])
+		assertList(t, []int{1, 2, 3, 3, 4, 5}, ll)
+
+		// Sorting should be stable, which shows when only part of each value is compared.
+		ll = newList(nodes(21, 10, 20, 11)...)
+		ll.Sort(func(a, b int) int {
+			return cmp.Compare(a/10, b/10)
+		})
+		assertList(t, []int{10, 11, 21, 20}, ll)
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		ll, other := newList(nodes(1, 3, 5)...), newList(nodes(2, 3, 4, 6, 7)...)
+		ll.Merge(other, cmp.Compare[int])
+		assertList(t, []int{1, 2, 3, 3, 4, 5, 6, 7}, ll)
+		assertList(t, nil, other)
+	})
+
+	t.Run("SplitAt", func(t *testing.T) {
+		ll := newList(nodes(1, 2, 3, 4)...)
+
+		_, err := ll.SplitAt(5)
+		assert.ErrorIs(t, err, linkedlist.ErrIndexOutOfRange)
+		_, err = ll.SplitAt(-1)
+		assert.ErrorIs(t, err, linkedlist.ErrIndexOutOfRange)
+
+		rest, err := ll.SplitAt(1)
+		assert.NoError(t, err)
+		assertList(t, []int{1}, ll)
+		assertList(t, []int{2, 3, 4}, rest)
+
+		end, err := rest.SplitAt(3)
+		assert.NoError(t, err)
+		assertList(t, []int{2, 3, 4}, rest)
+		assertList(t, nil, end)
+
+		all, err := rest.SplitAt(0)
+		assert.NoError(t, err)
+		assertList(t, nil, rest)
+		assertList(t, []int{2, 3, 4}, all)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		ll := newList(nodes(1, 2, 3, 4, 5, 6)...)
+		ll.Filter(func(v int) bool {
+			return v%2 == 1
+		})
+		assertList(t, []int{1, 3, 5}, ll)
+
+		ll.Filter(func(int) bool {
+			return false
+		})
+		assertList(t, nil, ll)
+	})
+
+	t.Run("Concat", func(t *testing.T) {
+		ll, other := newList(nodes(1, 2)...), newList(nodes(3, 4)...)
+		ll.Concat(other)
+		assertList(t, []int{1, 2, 3, 4}, ll)
+		assertList(t, nil, other)
+
+		empty := newList()
+		empty.Concat(ll)
+		assertList(t, []int{1, 2, 3, 4}, empty)
+	})
+}
+
+func TestLinkedList_Functional(t *testing.T) {
+	t.Run("Classic", func(t *testing.T) {
+		testFunctional(t, linkedlist.NewClassic[int])
+	})
+
+	t.Run("SingleLinkedTrackHeadAndTail", func(t *testing.T) {
+		testFunctional(t, linkedlist.NewSingleHeadTail[int])
+	})
+
+	t.Run("DoubleLinkedTrackHeadAndTail", func(t *testing.T) {
+		testFunctional(t, linkedlist.NewDoubleHeadTail[int])
+	})
+
+	t.Run("DoubleLinkedBackwardLinks", func(t *testing.T) {
+		ll := linkedlist.NewDoubleHeadTail(nodes(3, 1, 2)...)
+		ll.Sort(cmp.Compare[int])
+		ll.Reverse()
+		ll.Concat(linkedlist.NewDoubleHeadTail(nodes(0)...))
+		assert.Equal(t, []int{0, 1, 2, 3}, slices.Collect(ll.Backward()))
+	})
+}
+
+func TestMap(t *testing.T) {
+	src := linkedlist.NewClassic(nodes(1, 2, 3)...)
+	dst := linkedlist.Map(src, linkedlist.NewDoubleHeadTail[string](), strconv.Itoa)
+
+	assert.Equal(t, []string{"1", "2", "3"}, dst.ToArray())
+	assert.Equal(t, []int{1, 2, 3}, src.ToArray())
+}
+
+func TestSynchronized_Concurrent(t *testing.T) {
+	ll := linkedlist.NewSynchronized[int](linkedlist.NewDoubleHeadTail[int]())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				ll.InsertLast(linkedlist.NewNode(i*100 + j))
+				ll.Search(i * 100)
+				for range ll.All() {
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 800, ll.Size())
+
+	// Operations made inside Do should happen together.
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 50; j++ {
+				ll.Do(func(ll linkedlist.LinkedList[int]) {
+					first := ll.GetFirst()
+					ll.DeleteFirst()
+					ll.InsertLast(first)
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 800, ll.Size())
+}
+
+func TestLockFreeSet(t *testing.T) {
+	s := linkedlist.NewLockFreeSet(5, 1, 3)
+	assert.Equal(t, []int{1, 3, 5}, slices.Collect(s.All()))
+	assert.Equal(t, 3, s.Len())
+
+	assert.False(t, s.Add(3))
+	assert.True(t, s.Add(4))
+	assert.True(t, s.Contains(4))
+	assert.False(t, s.Contains(2))
+
+	assert.True(t, s.Remove(1))
+	assert.False(t, s.Remove(1))
+	assert.False(t, s.Contains(1))
+	assert.Equal(t, []int{3, 4, 5}, slices.Collect(s.All()))
+	assert.Equal(t, 3, s.Len())
+}
+
+func TestLockFreeSet_Concurrent(t *testing.T) {
+	s := linkedlist.NewLockFreeSet[int]()
+
+	// Each goroutine adds its own values and removes every other one, while another goroutine
+	// fights over a shared set of values.
+	const goroutines, perGoroutine = 8, 200
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < perGoroutine; j++ {
+				assert.True(t, s.Add(i*perGoroutine+j))
+			}
+			for j := 0; j < perGoroutine; j += 2 {
+				assert.True(t, s.Remove(i*perGoroutine+j))
+			}
+		}(i)
+	}
+
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 1000; j++ {
+				s.Add(-1 - j%10)
+				s.Remove(-1 - (j+5)%10)
+				s.Contains(j)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := 1; i <= 10; i++ {
+		s.Remove(-i)
+	}
+
+	var want []int
+	for i := 1; i < goroutines*perGoroutine; i += 2 {
+		want = append(want, i)
+	}
+	assert.Equal(t, want, slices.Collect(s.All()))
+	assert.Equal(t, len(want), s.Len())
+}
+
+func TestUnrolled(t *testing.T) {
+	u := linkedlist.NewUnrolled[int]()
+	_, ok := u.GetFirst()
+	assert.False(t, ok)
+	u.DeleteFirst()
+	u.DeleteLast()
+
+	u.InsertLast(2, 3)
+	u.InsertFirst(1, 0)
+	assert.Equal(t, []int{0, 1, 2, 3}, u.ToArray())
+
+	first, _ := u.GetFirst()
+	last, _ := u.GetLast()
+	assert.Equal(t, 0, first)
+	assert.Equal(t, 3, last)
+
+	assert.ErrorIs(t, u.InsertAfter(9, 10), linkedlist.ErrTargetNoExist)
+	assert.NoError(t, u.InsertAfter(3, 4, 1))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 1}, slices.Collect(u.All()))
+	assert.Equal(t, 1, u.IndexOf(1))
+	assert.Equal(t, 5, u.LastIndexOf(1))
+	assert.True(t, u.Contains(4))
+
+	u.Delete(1)
+	u.DeleteFirst()
+	u.DeleteLast()
+	assert.Equal(t, []int{2, 3, 4}, u.ToArray())
+	assert.Equal(t, 3, u.Size())
+
+	_, err := u.At(3)
+	assert.ErrorIs(t, err, linkedlist.ErrIndexOutOfRange)
+	assert.ErrorIs(t, u.InsertAt(4, 0), linkedlist.ErrIndexOutOfRange)
+	assert.ErrorIs(t, u.RemoveAt(-1), linkedlist.ErrIndexOutOfRange)
+
+	u.Clear()
+	assert.Empty(t, u.ToArray())
+	assert.Equal(t, 0, u.Size())
+}
+
+// TestUnrolled_Random checks the list against a slice through a long, seeded run of random
+// inserts and removals, which splits and merges plenty of nodes along the way.
+func TestUnrolled_Random(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	u := linkedlist.NewUnrolled[int]()
+
+	var want []int
+	for i := 0; i < 20000; i++ {
+		// Lean towards inserting so that the list grows to span many nodes.
+		if len(want) == 0 || rng.IntN(5) < 3 {
+			idx := rng.IntN(len(want) + 1)
+			assert.NoError(t, u.InsertAt(idx, i))
+			want = slices.Insert(want, idx, i)
+		} else {
+			idx := rng.IntN(len(want))
+			assert.NoError(t, u.RemoveAt(idx))
+			want = slices.Delete(want, idx, idx+1)
+		}
+	}
+
+	assert.Equal(t, want, u.ToArray())
+	assert.Equal(t, len(want), u.Size())
+	for _, idx := range []int{0, len(want) / 3, len(want) / 2, len(want) - 1} {
+		v, err := u.At(idx)
+		assert.NoError(t, err)
+		assert.Equal(t, want[idx], v)
+	}
+}
+
+// benchmarkSize is the number of values in the lists being benchmarked.
+const benchmarkSize = 1 << 16
+
+func BenchmarkIteration(b *testing.B) {
+	b.Run("Unrolled", func(b *testing.B) {
+		u := linkedlist.NewUnrolled[int]()
+		for i := 0; i < benchmarkSize; i++ {
+			u.InsertLast(i)
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			for v := range u.All() {
+				sum += v
+			}
+		}
+	})
+
+	b.Run("DoubleHeadTail", func(b *testing.B) {
+		ll := linkedlist.NewDoubleHeadTail[int]()
+		for i := 0; i < benchmarkSize; i++ {
+			ll.InsertLast(linkedlist.NewNode(i))
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			for v := range ll.All() {
+				sum += v
+			}
+		}
+	})
+}
+
+func BenchmarkInsertMiddle(b *testing.B) {
+	b.Run("Unrolled", func(b *testing.B) {
+		u := linkedlist.NewUnrolled[int]()
+		for i := 0; i < benchmarkSize; i++ {
+			u.InsertLast(i)
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = u.InsertAt(u.Size()/2, i)
+		}
+	})
+
+	b.Run("DoubleHeadTail", func(b *testing.B) {
+		ll := linkedlist.NewDoubleHeadTail[int]()
+		for i := 0; i < benchmarkSize; i++ {
+			ll.InsertLast(linkedlist.NewNode(i))
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = ll.InsertAt(ll.Size()/2, linkedlist.NewNode(i))
+		}
+	})
+}
+
+func TestArena(t *testing.T) {
+	a := linkedlist.NewArena[int]()
+	assert.Equal(t, linkedlist.Handle(0), a.GetFirst())
+	a.DeleteFirst()
+	a.Reverse()
+	a.Sort(cmp.Compare[int])
+
+	a.InsertLast(1, 2, 3)
+	a.InsertFirst(0)
+	assert.Equal(t, []int{0, 1, 2, 3}, a.ToArray())
+	assert.Equal(t, []int{3, 2, 1, 0}, slices.Collect(a.Backward()))
+
+	zero, three := a.GetFirst(), a.GetLast()
+	assert.Equal(t, 0, a.Value(zero))
+	assert.Equal(t, 3, a.Value(three))
+	assert.Equal(t, linkedlist.Handle(0), a.Prev(zero))
+	assert.Equal(t, linkedlist.Handle(0), a.Next(three))
+
+	a.MoveToFront(three)
+	a.MoveToBack(zero)
+	assert.Equal(t, []int{3, 1, 2, 0}, a.ToArray())
+
+	two := a.Search(2)
+	a.InsertBefore(two, 5, 6)
+	a.InsertAfterNode(two, 7)
+	assert.NoError(t, a.InsertAfter(0, 8))
+	assert.ErrorIs(t, a.InsertAfter(9, 10), linkedlist.ErrTargetNoExist)
+	assert.Equal(t, []int{3, 1, 5, 6, 2, 7, 0, 8}, a.ToArray())
+	assert.Equal(t, 8, a.Value(a.GetLast()))
+
+	// Removed slots should be reused rather than growing the arena.
+	a.Remove(two)
+	a.InsertFirst(9)
+	assert.Equal(t, two, a.GetFirst())
+	a.SetValue(two, 4)
+	assert.Equal(t, []int{4, 3, 1, 5, 6, 7, 0, 8}, a.ToArray())
+
+	h, err := a.At(6)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, a.Value(h))
+	assert.NoError(t, a.InsertAt(1, 2))
+	assert.NoError(t, a.RemoveAt(8))
+	assert.ErrorIs(t, a.RemoveAt(8), linkedlist.ErrIndexOutOfRange)
+	assert.Equal(t, []int{4, 2, 3, 1, 5, 6, 7, 0}, a.ToArray())
+	assert.Equal(t, 1, a.IndexOf(2))
+	assert.Equal(t, -1, a.LastIndexOf(9))
+
+	a.Reverse()
+	assert.Equal(t, []int{0, 7, 6, 5, 1, 3, 2, 4}, a.ToArray())
+	assert.Equal(t, []int{4, 2, 3, 1, 5, 6, 7, 0}, slices.Collect(a.Backward()))
+
+	a.Filter(func(v int) bool {
+		return v != 6
+	})
+	a.Sort(cmp.Compare[int])
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 7}, a.ToArray())
+
+	other := linkedlist.NewArena(3, 6, 8)
+	a.Merge(other, cmp.Compare[int])
+	assert.Equal(t, []int{0, 1, 2, 3, 3, 4, 5, 6, 7, 8}, a.ToArray())
+	assert.Equal(t, 0, other.Size())
+
+	rest, err := a.SplitAt(7)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 3, 4, 5}, a.ToArray())
+	assert.Equal(t, []int{6, 7, 8}, rest.ToArray())
+
+	a.Concat(rest)
+	assert.Equal(t, 10, a.Size())
+	assert.Equal(t, 0, rest.Size())
+
+	a.Clear()
+	assert.Empty(t, a.ToArray())
+	a.InsertLast(1)
+	assert.Equal(t, []int{1}, a.ToArray())
+}
+
+func BenchmarkInsertLast(b *testing.B) {
+	b.Run("Arena", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			a := linkedlist.NewArena[int]()
+			for j := 0; j < benchmarkSize; j++ {
+				a.InsertLast(j)
+			}
+		}
+	})
+
+	b.Run("DoubleHeadTail", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ll := linkedlist.NewDoubleHeadTail[int]()
+			for j := 0; j < benchmarkSize; j++ {
+				ll.InsertLast(linkedlist.NewNode(j))
+			}
+		}
+	})
+}
+
+// BenchmarkChurn removes from the front and inserts at the back of a full list, which once
+// the arena has grown should reuse freed slots rather than allocate.
+func BenchmarkChurn(b *testing.B) {
+	b.Run("Arena", func(b *testing.B) {
+		a := linkedlist.NewArena[int]()
+		for i := 0; i < benchmarkSize; i++ {
+			a.InsertLast(i)
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			a.DeleteFirst()
+			a.InsertLast(i)
+		}
+	})
+
+	b.Run("DoubleHeadTail", func(b *testing.B) {
+		ll := linkedlist.NewDoubleHeadTail[int]()
+		for i := 0; i < benchmarkSize; i++ {
+			ll.InsertLast(linkedlist.NewNode(i))
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			ll.DeleteFirst()
+			ll.InsertLast(linkedlist.NewNode(i))
+		}
+	})
+}
+
+func TestPersistent(t *testing.T) {
+	var empty *linkedlist.Persistent[int]
+	assert.Equal(t, 0, empty.Len())
+	assert.Nil(t, empty.Tail())
+	_, ok := empty.Head()
+	assert.False(t, ok)
+	assert.Empty(t, empty.ToArray())
+
+	base := linkedlist.NewPersistent(2, 3)
+	one := base.Prepend(1)
+	zero := base.Prepend(0)
+	appended := one.Append(4)
+	reversed := one.Reverse()
+
+	// Every version should be unaffected by the ones made from it.
+	assert.Equal(t, []int{2, 3}, base.ToArray())
+	assert.Equal(t, []int{1, 2, 3}, one.ToArray())
+	assert.Equal(t, []int{0, 2, 3}, zero.ToArray())
+	assert.Equal(t, []int{1, 2, 3, 4}, appended.ToArray())
+	assert.Equal(t, []int{3, 2, 1}, reversed.ToArray())
+	assert.Equal(t, 4, appended.Len())
+
+	// Prepend and Tail should share nodes rather than copy them.
+	assert.Same(t, base, one.Tail())
+	assert.Same(t, one.Tail(), zero.Tail())
+
+	head, ok := zero.Head()
+	assert.True(t, ok)
+	assert.Equal(t, 0, head)
+
+	// Versions can be read concurrently, and built upon concurrently, without locking.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			l := one
+			for j := 0; j < 100; j++ {
+				l = l.Prepend(i)
+			}
+			assert.Equal(t, 103, l.Len())
+			assert.Equal(t, []int{1, 2, 3}, one.ToArray())
+		}(i)
+	}
+	wg.Wait()
+}
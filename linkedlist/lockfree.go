@@ -0,0 +1,143 @@
+package linkedlist
+
+import (
+	"cmp"
+	"iter"
+	"sync/atomic"
+)
+
+// lockFreeLink is what a lockFreeNode points to next. It is never changed once created, so
+// that the pointer to the next node and the mark saying the node itself has been removed can
+// be swapped together with a single compare-and-swap.
+type lockFreeLink[T cmp.Ordered] struct {
+	node   *lockFreeNode[T]
+	marked bool
+}
+
+type lockFreeNode[T cmp.Ordered] struct {
+	value T
+	next  atomic.Pointer[lockFreeLink[T]]
+}
+
+// LockFreeSet is a sorted set built on a Harris lock-free linked list. Every operation works
+// by compare-and-swap rather than taking a lock, so goroutines never block each other and one
+// stalled goroutine can't hold up the rest. Add, Remove and Contains take O(n) time.
+//
+// Removing a value first marks its node, which stops anything being linked after it, and only
+// then unlinks it. Any operation that comes across a marked node helps finish unlinking it.
+type LockFreeSet[T cmp.Ordered] struct {
+	head *lockFreeNode[T]
+	size atomic.Int64
+}
+
+// NewLockFreeSet returns a LockFreeSet holding values.
+func NewLockFreeSet[T cmp.Ordered](values ...T) *LockFreeSet[T] {
+	s := LockFreeSet[T]{
+		head: &lockFreeNode[T]{},
+	}
+	s.head.next.Store(&lockFreeLink[T]{})
+
+	for i := range values {
+		s.Add(values[i])
+	}
+	return &s
+}
+
+// find returns the first unmarked node holding a value greater than or equal to value, which
+// is nil if there isn't one, along with the node before it and the link between the two.
+// Marked nodes found along the way are unlinked.
+func (s *LockFreeSet[T]) find(value T) (*lockFreeNode[T], *lockFreeNode[T], *lockFreeLink[T]) {
+retry:
+	pred := s.head
+	predLink := pred.next.Load()
+
+	for cur := predLink.node; cur != nil; {
+		curLink := cur.next.Load()
+
+		if curLink.marked {
+			// If pred's link has changed then pred has either been marked itself or had
+			// something linked after it, so start again from the head.
+			unlinked := &lockFreeLink[T]{node: curLink.node}
+			if !pred.next.CompareAndSwap(predLink, unlinked) {
+				goto retry
+			}
+
+			predLink, cur = unlinked, curLink.node
+			continue
+		}
+
+		if cur.value >= value {
+			return pred, cur, predLink
+		}
+		pred, predLink, cur = cur, curLink, curLink.node
+	}
+
+	return pred, nil, predLink
+}
+
+// Add adds value to the set, returning false if it was already there.
+func (s *LockFreeSet[T]) Add(value T) bool {
+	for {
+		pred, cur, predLink := s.find(value)
+		if cur != nil && cur.value == value {
+			return false
+		}
+
+		n := &lockFreeNode[T]{value: value}
+		n.next.Store(&lockFreeLink[T]{node: cur})
+		if pred.next.CompareAndSwap(predLink, &lockFreeLink[T]{node: n}) {
+			s.size.Add(1)
+			return true
+		}
+	}
+}
+
+// Remove removes value from the set, returning false if it wasn't there.
+func (s *LockFreeSet[T]) Remove(value T) bool {
+	for {
+		pred, cur, predLink := s.find(value)
+		if cur == nil || cur.value != value {
+			return false
+		}
+
+		// Marking the node is what removes it, and only one goroutine can win that.
+		curLink := cur.next.Load()
+		if curLink.marked || !cur.next.CompareAndSwap(curLink, &lockFreeLink[T]{node: curLink.node, marked: true}) {
+			continue
+		}
+		s.size.Add(-1)
+
+		// Try to unlink it straight away, leaving it to the next find if that fails.
+		pred.next.CompareAndSwap(predLink, &lockFreeLink[T]{node: curLink.node})
+		return true
+	}
+}
+
+// Contains returns whether value is in the set. It never writes, so it never has to retry.
+func (s *LockFreeSet[T]) Contains(value T) bool {
+	cur := s.head.next.Load().node
+	for cur != nil && cur.value < value {
+		cur = cur.next.Load().node
+	}
+	return cur != nil && cur.value == value && !cur.next.Load().marked
+}
+
+// Len returns the number of values in the set. Under concurrent changes it may already be out
+// of date by the time it returns.
+func (s *LockFreeSet[T]) Len() int {
+	return int(s.size.Load())
+}
+
+// All returns an iterator over the values in the set in ascending order. Values added or
+// removed while iterating may or may not be seen.
+func (s *LockFreeSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for cur := s.head.next.Load().node; cur != nil; {
+			link := cur.next.Load()
+			if !link.marked && !yield(cur.value) {
+				return
+			}
+			cur = link.node
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package linkedlist
+
+// Map inserts the result of calling fn on every value in src, in order, onto the end of dst
+// and returns dst. src is left untouched.
+func Map[T, U comparable, L LinkedList[U]](src LinkedList[T], dst L, fn func(T) U) L {
+	for v := range src.All() {
+		dst.InsertLast(NewNode(fn(v)))
+	}
+	return dst
+}
@@ -1,11 +1,11 @@
 package linkedlist
 
-type Node[T comparable] struct {
+type Node[T any] struct {
 	Data       T
 	Next, Prev *Node[T]
 }
 
-func NewNode[T comparable](val T) *Node[T] {
+func NewNode[T any](val T) *Node[T] {
 	return &Node[T]{
 		Data: val,
 	}
@@ -0,0 +1,95 @@
+package linkedlist
+
+import "iter"
+
+// Persistent is an immutable singly linked list. Nothing ever changes a Persistent once it has
+// been made; the operations that would change it return a new version instead, which shares
+// as many of its nodes with the old one as it can. Old versions stay valid, and any version can
+// be used from any number of goroutines at once without locking.
+//
+// A nil *Persistent is the empty list, so the zero value is ready to use.
+type Persistent[T any] struct {
+	head T
+	tail *Persistent[T]
+	size int
+}
+
+// NewPersistent returns a persistent linked list holding values (in order of parameter index).
+func NewPersistent[T any](values ...T) *Persistent[T] {
+	var p *Persistent[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		p = p.Prepend(values[i])
+	}
+	return p
+}
+
+// Len returns the number of values in the list in constant time.
+func (p *Persistent[T]) Len() int {
+	if p == nil {
+		return 0
+	}
+	return p.size
+}
+
+// Head returns the first value in the list, if there is one.
+func (p *Persistent[T]) Head() (T, bool) {
+	if p == nil {
+		var v T
+		return v, false
+	}
+	return p.head, true
+}
+
+// Tail returns the list without its first value in constant time, sharing every node with p.
+// The tail of the empty list is the empty list.
+func (p *Persistent[T]) Tail() *Persistent[T] {
+	if p == nil {
+		return nil
+	}
+	return p.tail
+}
+
+// Prepend returns the list with v in front of it in constant time, sharing every node with p.
+func (p *Persistent[T]) Prepend(v T) *Persistent[T] {
+	return &Persistent[T]{
+		head: v,
+		tail: p,
+		size: p.Len() + 1,
+	}
+}
+
+// Append returns the list with v on the end of it. Every node in a list links to the rest of
+// it, so none of the nodes of p can be shared and they are all copied, which takes O(n) time
+// and memory. Build lists with Prepend, and Reverse them at the end if needed, where possible.
+func (p *Persistent[T]) Append(v T) *Persistent[T] {
+	return NewPersistent(append(p.ToArray(), v)...)
+}
+
+// Reverse returns the list in reverse order. It takes O(n) time and memory, since no nodes can
+// be shared.
+func (p *Persistent[T]) Reverse() *Persistent[T] {
+	var res *Persistent[T]
+	for v := range p.All() {
+		res = res.Prepend(v)
+	}
+	return res
+}
+
+// All returns an iterator over the values in the list from head to tail.
+func (p *Persistent[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for cur := p; cur != nil; cur = cur.tail {
+			if !yield(cur.head) {
+				return
+			}
+		}
+	}
+}
+
+func (p *Persistent[T]) ToArray() []T {
+	var res []T
+	for v := range p.All() {
+		res = append(res, v)
+	}
+	return res
+}
@@ -1,5 +1,7 @@
 package linkedlist
 
+import "iter"
+
 var _ LinkedList[int] = &SingleHeadTail[int]{}
 
 // SingleHeadTail is a single linked list that tracks both the head and the tail.
@@ -77,15 +79,19 @@ func (ll *SingleHeadTail[T]) InsertAfter(target T, nodes ...*Node[T]) error {
 		return ErrTargetNoExist
 	}
 
-	ll.size++
-
 	rightSide := after.Next
 	for i := range nodes {
 		after.Next = nodes[i]
 		after = nodes[i]
+		ll.size++
 	}
 	after.Next = rightSide
 
+	// Inserting after the tail moves it to the last of the new nodes.
+	if rightSide == nil {
+		ll.tail = after
+	}
+
 	return nil
 }
 
@@ -155,10 +161,12 @@ func (ll *SingleHeadTail[T]) Delete(target T) {
 		return
 	}
 
-	prev := ll.head
-	for cur := prev.Next; cur != nil; cur = cur.Next {
-		if cur.Data == target {
-			prev.Next = cur.Next
+	for prev := ll.head; prev.Next != nil; prev = prev.Next {
+		if prev.Next.Data == target {
+			if prev.Next == ll.tail {
+				ll.tail = prev
+			}
+			prev.Next = prev.Next.Next
 			ll.size--
 			return
 		}
@@ -170,3 +178,161 @@ func (ll *SingleHeadTail[T]) Clear() {
 	ll.tail = nil
 	ll.size = 0
 }
+
+// All returns an iterator over the values in the list from head to tail.
+func (ll *SingleHeadTail[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for cur := ll.head; cur != nil; cur = cur.Next {
+			if !yield(cur.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Reverse reverses the order of the list in place.
+func (ll *SingleHeadTail[T]) Reverse() {
+	ll.tail = ll.head
+	ll.head = reverseChain(ll.head)
+}
+
+// Sort stable sorts the list in place. cmp should return a negative number when a < b, a
+// positive number when a > b and zero when they are equal, like cmp.Compare.
+func (ll *SingleHeadTail[T]) Sort(cmp func(a, b T) int) {
+	ll.head = sortChain(ll.head, cmp)
+	ll.tail = chainTail(ll.head)
+}
+
+// Merge moves the nodes of other into the list, keeping it sorted. Both lists must already be
+// sorted by cmp. other is left empty.
+func (ll *SingleHeadTail[T]) Merge(other *SingleHeadTail[T], cmp func(a, b T) int) {
+	ll.head = mergeChains(ll.head, other.head, cmp)
+	ll.tail = chainTail(ll.head)
+	ll.size += other.size
+	other.Clear()
+}
+
+// SplitAt leaves the nodes before index in the list and moves the rest into a new list.
+func (ll *SingleHeadTail[T]) SplitAt(index int) (*SingleHeadTail[T], error) {
+	if index < 0 || index > ll.size {
+		return nil, ErrIndexOutOfRange
+	}
+
+	if index == 0 {
+		rest := *ll
+		ll.Clear()
+		return &rest, nil
+	}
+
+	rest := SingleHeadTail[T]{
+		tail: ll.tail,
+		size: ll.size - index,
+	}
+
+	last := nodeAt(ll.head, index-1)
+	if rest.size != 0 {
+		rest.head = last.Next
+	} else {
+		rest.tail = nil
+	}
+
+	last.Next = nil
+	ll.tail = last
+	ll.size = index
+
+	return &rest, nil
+}
+
+// Filter removes every node from the list whose value doesn't satisfy keep.
+func (ll *SingleHeadTail[T]) Filter(keep func(T) bool) {
+	ll.head, ll.tail, ll.size = filterChain(ll.head, keep)
+}
+
+// Concat moves the nodes of other onto the end of the list, leaving other empty.
+func (ll *SingleHeadTail[T]) Concat(other *SingleHeadTail[T]) {
+	if other.Size() == 0 {
+		return
+	}
+
+	if ll.Size() == 0 {
+		ll.head = other.head
+	} else {
+		ll.tail.Next = other.head
+	}
+
+	ll.tail = other.tail
+	ll.size += other.size
+	other.Clear()
+}
+
+// At returns the node at index.
+func (ll *SingleHeadTail[T]) At(index int) (*Node[T], error) {
+	if index < 0 || index >= ll.size {
+		return nil, ErrIndexOutOfRange
+	}
+
+	if index == ll.size-1 {
+		return ll.tail, nil
+	}
+	return nodeAt(ll.head, index), nil
+}
+
+// InsertAt links nodes, in order, into the list so that the first of them ends up at index.
+// An index equal to the size of the list appends them.
+func (ll *SingleHeadTail[T]) InsertAt(index int, nodes ...*Node[T]) error {
+	if index < 0 || index > ll.size {
+		return ErrIndexOutOfRange
+	}
+
+	switch index {
+	case ll.size:
+		ll.InsertLast(nodes...)
+	case 0:
+		// InsertFirst pushes each node in front of the last, so go backwards to keep them in
+		// order.
+		for i := len(nodes) - 1; i >= 0; i-- {
+			ll.InsertFirst(nodes[i])
+		}
+	default:
+		after := nodeAt(ll.head, index-1)
+		for i := range nodes {
+			nodes[i].Next = after.Next
+			after.Next = nodes[i]
+			after = nodes[i]
+			ll.size++
+		}
+	}
+
+	return nil
+}
+
+// RemoveAt unlinks the node at index.
+func (ll *SingleHeadTail[T]) RemoveAt(index int) error {
+	if index < 0 || index >= ll.size {
+		return ErrIndexOutOfRange
+	}
+
+	if index == 0 {
+		ll.DeleteFirst()
+		return nil
+	}
+
+	prev := nodeAt(ll.head, index-1)
+	if prev.Next == ll.tail {
+		ll.tail = prev
+	}
+	prev.Next = prev.Next.Next
+	ll.size--
+
+	return nil
+}
+
+// IndexOf returns the index of the first node holding target, or -1 if there isn't one.
+func (ll *SingleHeadTail[T]) IndexOf(target T) int {
+	return indexOf(ll.head, ll.size, target)
+}
+
+// LastIndexOf returns the index of the last node holding target, or -1 if there isn't one.
+func (ll *SingleHeadTail[T]) LastIndexOf(target T) int {
+	return lastIndexOf(ll.head, ll.size, target)
+}
@@ -0,0 +1,166 @@
+package linkedlist
+
+import (
+	"iter"
+	"sync"
+)
+
+var _ LinkedList[int] = &Synchronized[int]{}
+
+// Synchronized wraps a LinkedList so that it is safe for concurrent use. Reads share a read
+// lock and everything else takes the write lock.
+//
+// Nodes handed out by GetFirst, GetLast, Search and At still belong to the wrapped list, so
+// reading or changing them once the call has returned is not synchronized. Use Do to work with
+// nodes while holding the lock.
+type Synchronized[T comparable] struct {
+	ll LinkedList[T]
+	mu sync.RWMutex
+}
+
+// NewSynchronized returns a Synchronized wrapping ll. ll must not be used directly afterwards.
+func NewSynchronized[T comparable](ll LinkedList[T]) *Synchronized[T] {
+	return &Synchronized[T]{
+		ll: ll,
+	}
+}
+
+// Do calls fn with the wrapped list while holding the write lock, so that several operations
+// can be made to happen atomically. fn must not use s, or the list once it has returned.
+func (s *Synchronized[T]) Do(fn func(ll LinkedList[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn(s.ll)
+}
+
+func (s *Synchronized[T]) GetFirst() *Node[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.ll.GetFirst()
+}
+
+func (s *Synchronized[T]) GetLast() *Node[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.ll.GetLast()
+}
+
+func (s *Synchronized[T]) InsertFirst(nodes ...*Node[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ll.InsertFirst(nodes...)
+}
+
+func (s *Synchronized[T]) InsertLast(nodes ...*Node[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ll.InsertLast(nodes...)
+}
+
+func (s *Synchronized[T]) InsertAfter(target T, nodes ...*Node[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ll.InsertAfter(target, nodes...)
+}
+
+func (s *Synchronized[T]) DeleteFirst() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ll.DeleteFirst()
+}
+
+func (s *Synchronized[T]) DeleteLast() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ll.DeleteLast()
+}
+
+func (s *Synchronized[T]) Delete(target T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ll.Delete(target)
+}
+
+func (s *Synchronized[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.ll.Size()
+}
+
+func (s *Synchronized[T]) Search(target T) *Node[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.ll.Search(target)
+}
+
+func (s *Synchronized[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ll.Clear()
+}
+
+func (s *Synchronized[T]) ToArray() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.ll.ToArray()
+}
+
+// All returns an iterator over a snapshot of the values in the list, taken when iteration
+// starts, so the list can be changed while iterating without holding anything up.
+func (s *Synchronized[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.ToArray() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Synchronized[T]) At(index int) (*Node[T], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.ll.At(index)
+}
+
+func (s *Synchronized[T]) InsertAt(index int, nodes ...*Node[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ll.InsertAt(index, nodes...)
+}
+
+func (s *Synchronized[T]) RemoveAt(index int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ll.RemoveAt(index)
+}
+
+func (s *Synchronized[T]) IndexOf(target T) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.ll.IndexOf(target)
+}
+
+func (s *Synchronized[T]) LastIndexOf(target T) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.ll.LastIndexOf(target)
+}
@@ -0,0 +1,305 @@
+package linkedlist
+
+import "iter"
+
+// unrolledCapacity is the most values each node of an Unrolled list holds.
+const unrolledCapacity = 32
+
+type unrolledNode[T comparable] struct {
+	values     [unrolledCapacity]T
+	count      int
+	prev, next *unrolledNode[T]
+}
+
+// Unrolled is a doubly linked list that stores up to unrolledCapacity values in an array in
+// each node, rather than one. Walking the list mostly moves through contiguous memory instead
+// of chasing a pointer per value, and finding an index only has to walk over nodes.
+//
+// A full node is split in half to make room for an insert, and a node that drops below half
+// full either borrows a value from or merges with the node after it, so that every node but the
+// last stays at least half full.
+//
+// Since values don't have a Node of their own, the operations that would take or return one
+// work with values instead.
+type Unrolled[T comparable] struct {
+	head, tail *unrolledNode[T]
+	size       int
+}
+
+// NewUnrolled returns an unrolled linked list prepopulated with any values passed as arguments
+// (in order of parameter index).
+func NewUnrolled[T comparable](values ...T) *Unrolled[T] {
+	var u Unrolled[T]
+	u.InsertLast(values...)
+	return &u
+}
+
+func (u *Unrolled[T]) GetFirst() (T, bool) {
+	if u.size == 0 {
+		var v T
+		return v, false
+	}
+	return u.head.values[0], true
+}
+
+func (u *Unrolled[T]) GetLast() (T, bool) {
+	if u.size == 0 {
+		var v T
+		return v, false
+	}
+	return u.tail.values[u.tail.count-1], true
+}
+
+func (u *Unrolled[T]) InsertFirst(values ...T) {
+	for i := range values {
+		if u.size == 0 {
+			u.InsertLast(values[i])
+			continue
+		}
+		u.insert(u.head, 0, values[i])
+	}
+}
+
+func (u *Unrolled[T]) InsertLast(values ...T) {
+	for i := range values {
+		if u.tail == nil {
+			u.head = &unrolledNode[T]{}
+			u.tail = u.head
+		}
+		u.insert(u.tail, u.tail.count, values[i])
+	}
+}
+
+func (u *Unrolled[T]) InsertAfter(target T, values ...T) error {
+	idx := u.IndexOf(target)
+	if idx == -1 {
+		return ErrTargetNoExist
+	}
+	return u.InsertAt(idx+1, values...)
+}
+
+func (u *Unrolled[T]) DeleteFirst() {
+	if u.size != 0 {
+		u.remove(u.head, 0)
+	}
+}
+
+func (u *Unrolled[T]) DeleteLast() {
+	if u.size != 0 {
+		u.remove(u.tail, u.tail.count-1)
+	}
+}
+
+func (u *Unrolled[T]) Delete(target T) {
+	for n := u.head; n != nil; n = n.next {
+		for i := 0; i < n.count; i++ {
+			if n.values[i] == target {
+				u.remove(n, i)
+				return
+			}
+		}
+	}
+}
+
+func (u *Unrolled[T]) Size() int {
+	return u.size
+}
+
+// Contains returns whether any value in the list is equal to target.
+func (u *Unrolled[T]) Contains(target T) bool {
+	return u.IndexOf(target) != -1
+}
+
+func (u *Unrolled[T]) Clear() {
+	u.head = nil
+	u.tail = nil
+	u.size = 0
+}
+
+func (u *Unrolled[T]) ToArray() []T {
+	res := make([]T, 0, u.size)
+	for n := u.head; n != nil; n = n.next {
+		res = append(res, n.values[:n.count]...)
+	}
+	return res
+}
+
+// All returns an iterator over the values in the list from head to tail.
+func (u *Unrolled[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := u.head; n != nil; n = n.next {
+			for i := 0; i < n.count; i++ {
+				if !yield(n.values[i]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// At returns the value at index.
+func (u *Unrolled[T]) At(index int) (T, error) {
+	if index < 0 || index >= u.size {
+		var v T
+		return v, ErrIndexOutOfRange
+	}
+
+	n, i := u.locate(index)
+	return n.values[i], nil
+}
+
+// InsertAt inserts values, in order, into the list so that the first of them ends up at
+// index. An index equal to the size of the list appends them.
+func (u *Unrolled[T]) InsertAt(index int, values ...T) error {
+	if index < 0 || index > u.size {
+		return ErrIndexOutOfRange
+	}
+
+	for i := range values {
+		if index+i == u.size {
+			u.InsertLast(values[i:]...)
+			break
+		}
+
+		n, j := u.locate(index + i)
+		u.insert(n, j, values[i])
+	}
+	return nil
+}
+
+// RemoveAt removes the value at index.
+func (u *Unrolled[T]) RemoveAt(index int) error {
+	if index < 0 || index >= u.size {
+		return ErrIndexOutOfRange
+	}
+
+	n, i := u.locate(index)
+	u.remove(n, i)
+	return nil
+}
+
+// IndexOf returns the index of the first value equal to target, or -1 if there isn't one.
+func (u *Unrolled[T]) IndexOf(target T) int {
+	base := 0
+	for n := u.head; n != nil; n = n.next {
+		for i := 0; i < n.count; i++ {
+			if n.values[i] == target {
+				return base + i
+			}
+		}
+		base += n.count
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last value equal to target, or -1 if there isn't one.
+// It walks backwards from the tail so it can stop at the first match.
+func (u *Unrolled[T]) LastIndexOf(target T) int {
+	base := u.size
+	for n := u.tail; n != nil; n = n.prev {
+		base -= n.count
+		for i := n.count - 1; i >= 0; i-- {
+			if n.values[i] == target {
+				return base + i
+			}
+		}
+	}
+	return -1
+}
+
+// locate returns the node holding index and where index is within it, walking from whichever
+// end of the list is closer. index must be within the list.
+func (u *Unrolled[T]) locate(index int) (*unrolledNode[T], int) {
+	if index < u.size/2 {
+		n := u.head
+		for index >= n.count {
+			index -= n.count
+			n = n.next
+		}
+		return n, index
+	}
+
+	n, base := u.tail, u.size-u.tail.count
+	for index < base {
+		n = n.prev
+		base -= n.count
+	}
+	return n, index - base
+}
+
+// insert inserts v at position i of n, splitting n first if it is full.
+func (u *Unrolled[T]) insert(n *unrolledNode[T], i int, v T) {
+	if n.count == unrolledCapacity {
+		// Move the back half of n into a new node after it.
+		half := unrolledCapacity / 2
+		split := &unrolledNode[T]{
+			count: unrolledCapacity - half,
+			prev:  n,
+			next:  n.next,
+		}
+		copy(split.values[:], n.values[half:])
+		clear(n.values[half:])
+		n.count = half
+
+		if n.next != nil {
+			n.next.prev = split
+		} else {
+			u.tail = split
+		}
+		n.next = split
+
+		if i > half {
+			n, i = split, i-half
+		}
+	}
+
+	copy(n.values[i+1:n.count+1], n.values[i:n.count])
+	n.values[i] = v
+	n.count++
+	u.size++
+}
+
+// remove removes the value at position i of n, then borrows from or merges with the next node
+// if that leaves n less than half full.
+func (u *Unrolled[T]) remove(n *unrolledNode[T], i int) {
+	copy(n.values[i:n.count-1], n.values[i+1:n.count])
+	n.count--
+	clear(n.values[n.count : n.count+1])
+	u.size--
+
+	half := unrolledCapacity / 2
+	switch {
+	case n.count == 0:
+		u.unlink(n)
+	case n.count >= half || n.next == nil:
+	case n.next.count > half:
+		// The next node can spare a value without going below half full itself.
+		next := n.next
+		n.values[n.count] = next.values[0]
+		n.count++
+
+		copy(next.values[:next.count-1], next.values[1:next.count])
+		next.count--
+		clear(next.values[next.count : next.count+1])
+	default:
+		next := n.next
+		copy(n.values[n.count:], next.values[:next.count])
+		n.count += next.count
+		u.unlink(next)
+	}
+}
+
+// unlink removes the node n from the list of nodes.
+func (u *Unrolled[T]) unlink(n *unrolledNode[T]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		u.head = n.next
+	}
+
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		u.tail = n.prev
+	}
+}
@@ -1,44 +1,136 @@
+// Package queue provides a FIFO queue. Dequeue and Peek panic with ErrEmpty when the queue is
+// empty; TryDequeue and TryPeek report it with a false ok instead.
 package queue
 
-import "sync"
+import (
+	"errors"
+	"iter"
+	"sync"
+)
 
+// ErrEmpty is what Dequeue and Peek panic with when the queue is empty.
+var ErrEmpty = errors.New("queue is empty")
+
+// minCapacity is the smallest the buffer of a Queue is allocated at, and the size below which
+// it stops shrinking.
+const minCapacity = 8
+
+// Queue is a FIFO queue that is safe for concurrent use. Values are held in a ring buffer that
+// doubles in size when it fills up and halves in size when it drops below a quarter full, so a
+// queue that was once long doesn't keep holding on to the memory it needed then.
 type Queue[T any] struct {
-	data []T
-	mu   *sync.Mutex
+	// buf holds count values, starting at head and wrapping around to the start of buf.
+	buf         []T
+	head, count int
+
+	mu sync.Mutex
 }
 
 func NewQueue[T any]() *Queue[T] {
-	return &Queue[T]{
-		mu: &sync.Mutex{},
-	}
+	return &Queue[T]{}
 }
 
 func (q *Queue[T]) Enqueue(val T) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	q.data = append(q.data, val)
+	if q.count == len(q.buf) {
+		q.resize(max(2*len(q.buf), minCapacity))
+	}
+
+	q.buf[(q.head+q.count)%len(q.buf)] = val
+	q.count++
 }
 
+// Dequeue removes and returns the value at the front of the queue. It panics with ErrEmpty if
+// the queue is empty.
 func (q *Queue[T]) Dequeue() T {
+	val, ok := q.TryDequeue()
+	if !ok {
+		panic(ErrEmpty)
+	}
+	return val
+}
+
+// TryDequeue removes and returns the value at the front of the queue, if there is one.
+func (q *Queue[T]) TryDequeue() (T, bool) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	val := q.data[0]
-	q.data = q.data[1:]
-	return val
+	if q.count == 0 {
+		var val T
+		return val, false
+	}
+
+	// Zero the slot so that the queue doesn't keep whatever val points to alive.
+	var zero T
+	val := q.buf[q.head]
+	q.buf[q.head] = zero
+	q.head = (q.head + 1) % len(q.buf)
+	q.count--
+
+	if len(q.buf) > minCapacity && q.count < len(q.buf)/4 {
+		q.resize(len(q.buf) / 2)
+	}
+	return val, true
 }
 
+// Peek returns the value at the front of the queue. It panics with ErrEmpty if the queue is
+// empty.
 func (q *Queue[T]) Peek() T {
+	val, ok := q.TryPeek()
+	if !ok {
+		panic(ErrEmpty)
+	}
+	return val
+}
+
+// TryPeek returns the value at the front of the queue, if there is one.
+func (q *Queue[T]) TryPeek() (T, bool) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	return q.data[0]
+	if q.count == 0 {
+		var val T
+		return val, false
+	}
+	return q.buf[q.head], true
 }
 
 func (q *Queue[T]) Len() int {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	return len(q.data)
+	return q.count
+}
+
+// All returns an iterator over the values in the queue from front to back. It iterates over
+// a snapshot taken when iteration starts, so the queue is free to change during iteration.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		q.mu.Lock()
+		data := q.values(make([]T, q.count))
+		q.mu.Unlock()
+
+		for i := range data {
+			if !yield(data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// values copies the values in the queue from front to back into dst, which must have room for
+// them all, and returns it. The caller must hold q.mu.
+func (q *Queue[T]) values(dst []T) []T {
+	n := copy(dst, q.buf[q.head:min(q.head+q.count, len(q.buf))])
+	copy(dst[n:], q.buf[:q.count-n])
+	return dst
+}
+
+// resize moves the values in the queue into a new buffer of the given capacity, unwrapping
+// them so that the front of the queue is at the start of it. The caller must hold q.mu.
+func (q *Queue[T]) resize(capacity int) {
+	q.buf = q.values(make([]T, capacity))
+	q.head = 0
 }
@@ -1,6 +1,9 @@
 package queue_test
 
 import (
+	"runtime"
+	"slices"
+	"sync"
 	"testing"
 
 	"github.com/george-e-shaw-iv/go/queue"
@@ -33,3 +36,214 @@ func TestQueue(t *testing.T) {
 
 	assert.Equal(t, 0, q.Len())
 }
+
+func TestQueue_All(t *testing.T) {
+	q := queue.NewQueue[int]()
+
+	q.Enqueue(0)
+	q.Enqueue(1)
+	q.Enqueue(2)
+
+	assert.Equal(t, []int{0, 1, 2}, slices.Collect(q.All()))
+
+	// The queue is free to change during iteration.
+	for v := range q.All() {
+		q.Enqueue(v)
+		if v == 1 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 1, 2, 0, 1}, slices.Collect(q.All()))
+}
+
+func TestQueue_Empty(t *testing.T) {
+	var q queue.Queue[int]
+
+	_, ok := q.TryDequeue()
+	assert.False(t, ok)
+	_, ok = q.TryPeek()
+	assert.False(t, ok)
+
+	assert.PanicsWithValue(t, queue.ErrEmpty, func() { q.Dequeue() })
+	assert.PanicsWithValue(t, queue.ErrEmpty, func() { q.Peek() })
+
+	q.Enqueue(1)
+
+	v, ok := q.TryPeek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	v, ok = q.TryDequeue()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	_, ok = q.TryDequeue()
+	assert.False(t, ok)
+}
+
+func TestQueue_TryDequeueConcurrent(t *testing.T) {
+	var q queue.Queue[int]
+	for i := 0; i < 1000; i++ {
+		q.Enqueue(i)
+	}
+
+	// Consumers racing to drain the queue should never panic, and between them should see
+	// every value exactly once.
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen []int
+	)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				v, ok := q.TryDequeue()
+				if !ok {
+					return
+				}
+
+				mu.Lock()
+				seen = append(seen, v)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	want := make([]int, 1000)
+	for i := range want {
+		want[i] = i
+	}
+
+	slices.Sort(seen)
+	assert.Equal(t, want, seen)
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestQueue_Wraparound(t *testing.T) {
+	var (
+		q    queue.Queue[int]
+		want []int
+	)
+
+	// Interleave runs of enqueues and dequeues of different lengths so the values wrap around
+	// the end of the buffer while it grows and shrinks.
+	next := 0
+	for round := 0; round < 50; round++ {
+		for i := 0; i < (round*7)%23; i++ {
+			q.Enqueue(next)
+			want = append(want, next)
+			next++
+		}
+
+		assert.Equal(t, want, slices.Collect(q.All()))
+
+		for i := 0; i < (round*5)%19 && len(want) > 0; i++ {
+			assert.Equal(t, want[0], q.Dequeue())
+			want = want[1:]
+		}
+
+		assert.Equal(t, len(want), q.Len())
+	}
+
+	for len(want) > 0 {
+		assert.Equal(t, want[0], q.Dequeue())
+		want = want[1:]
+	}
+	assert.Equal(t, 0, q.Len())
+}
+
+// sliceQueue is how Queue used to be implemented, kept so that the benchmarks can compare
+// against it.
+type sliceQueue[T any] struct {
+	data []T
+	mu   sync.Mutex
+}
+
+func (q *sliceQueue[T]) Enqueue(val T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.data = append(q.data, val)
+}
+
+func (q *sliceQueue[T]) Dequeue() T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	val := q.data[0]
+	q.data = q.data[1:]
+	return val
+}
+
+type fifo interface {
+	Enqueue(val *int)
+	Dequeue() *int
+}
+
+func benchmarkQueues(b *testing.B, fn func(b *testing.B, newQueue func() fifo)) {
+	b.Run("Ring", func(b *testing.B) {
+		fn(b, func() fifo {
+			return queue.NewQueue[*int]()
+		})
+	})
+
+	b.Run("Slice", func(b *testing.B) {
+		fn(b, func() fifo {
+			return &sliceQueue[*int]{}
+		})
+	})
+}
+
+// BenchmarkQueue_Steady keeps a queue at a constant length, enqueueing one value for every
+// one dequeued.
+func BenchmarkQueue_Steady(b *testing.B) {
+	benchmarkQueues(b, func(b *testing.B, newQueue func() fifo) {
+		q, v := newQueue(), new(int)
+		for i := 0; i < 1<<10; i++ {
+			q.Enqueue(v)
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			q.Enqueue(v)
+			q.Dequeue()
+		}
+	})
+}
+
+// BenchmarkQueue_Burst fills a queue up and then drains it, and reports how much memory it is
+// still holding on to afterwards.
+func BenchmarkQueue_Burst(b *testing.B) {
+	benchmarkQueues(b, func(b *testing.B, newQueue func() fifo) {
+		const burst = 1 << 16
+
+		var retained uint64
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			q := newQueue()
+			for j := 0; j < burst; j++ {
+				q.Enqueue(new(int))
+			}
+			for j := 0; j < burst-1; j++ {
+				q.Dequeue()
+			}
+
+			b.StopTimer()
+			var before, after runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+			runtime.KeepAlive(q)
+			q = nil
+			runtime.GC()
+			runtime.ReadMemStats(&after)
+			retained += before.HeapAlloc - min(before.HeapAlloc, after.HeapAlloc)
+			b.StartTimer()
+		}
+		b.ReportMetric(float64(retained)/float64(b.N), "retained-B/op")
+	})
+}
@@ -0,0 +1,271 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+// maxLevel is the most levels any node can have, which comfortably covers 4^32 entries.
+const maxLevel = 32
+
+type opts struct {
+	seed    uint64
+	seedSet bool
+}
+
+func (o *opts) apply(skipListOptions ...Option) {
+	for i := range skipListOptions {
+		skipListOptions[i](o)
+	}
+}
+
+type Option func(*opts)
+
+// WithSeed seeds the generator that picks how many levels each new node gets, so that the
+// shape of the list is the same every time the same keys are inserted in the same order.
+// Without it the generator is seeded randomly.
+func WithSeed(seed uint64) Option {
+	return func(o *opts) {
+		o.seed = seed
+		o.seedSet = true
+	}
+}
+
+type node[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+
+	// next holds the next node on each level this node is part of, and span holds how many
+	// nodes on the bottom level each of those links skips over, which is what makes rank
+	// queries logarithmic.
+	next []*node[K, V]
+	span []int
+}
+
+// SkipList is an ordered map. Every node is part of the bottom level, which links all of them
+// in key order, and each level above it holds roughly a quarter of the nodes of the level
+// below, so searches can skip over most of the list. Insert, delete and search all take
+// O(log n) expected time.
+//
+// A SkipList is not safe for concurrent use.
+type SkipList[K cmp.Ordered, V any] struct {
+	head  *node[K, V]
+	level int
+	size  int
+	rng   *rand.Rand
+}
+
+// New returns an empty skip list.
+func New[K cmp.Ordered, V any](options ...Option) *SkipList[K, V] {
+	var o opts
+	o.apply(options...)
+
+	if !o.seedSet {
+		o.seed = rand.Uint64()
+	}
+
+	return &SkipList[K, V]{
+		head: &node[K, V]{
+			next: make([]*node[K, V], maxLevel),
+			span: make([]int, maxLevel),
+		},
+		level: 1,
+		rng:   rand.New(rand.NewPCG(o.seed, o.seed)),
+	}
+}
+
+// randomLevel returns the number of levels for a new node, which is one more than the number
+// of times in a row the generator came up with a one in four chance.
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < maxLevel && s.rng.IntN(4) == 0 {
+		level++
+	}
+	return level
+}
+
+// search returns, for each level, the last node whose key is less than k along with the
+// rank of that node, where the head has a rank of zero.
+func (s *SkipList[K, V]) search(k K) (update [maxLevel]*node[K, V], rank [maxLevel]int) {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+
+		for x.next[i] != nil && x.next[i].key < k {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	return update, rank
+}
+
+func (s *SkipList[K, V]) Len() int {
+	return s.size
+}
+
+func (s *SkipList[K, V]) Get(k K) (V, bool) {
+	update, _ := s.search(k)
+	if n := update[0].next[0]; n != nil && n.key == k {
+		return n.value, true
+	}
+
+	var v V
+	return v, false
+}
+
+// Put stores v under k, replacing the value already stored under k if there is one.
+func (s *SkipList[K, V]) Put(k K, v V) {
+	update, rank := s.search(k)
+	if n := update[0].next[0]; n != nil && n.key == k {
+		n.value = v
+		return
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		// The new levels only have the head on them so far, and its links there skip over
+		// the whole list.
+		for i := s.level; i < level; i++ {
+			update[i] = s.head
+			s.head.span[i] = s.size
+		}
+		s.level = level
+	}
+
+	n := &node[K, V]{
+		key:   k,
+		value: v,
+		next:  make([]*node[K, V], level),
+		span:  make([]int, level),
+	}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+
+		// rank[0]-rank[i] is how far update[i] is behind the node n was inserted after.
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+
+	// Links on higher levels now skip over one more node.
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+
+	s.size++
+}
+
+// Delete removes k from the list, returning whether it was there.
+func (s *SkipList[K, V]) Delete(k K) bool {
+	update, _ := s.search(k)
+
+	n := update[0].next[0]
+	if n == nil || n.key != k {
+		return false
+	}
+
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == n {
+			update[i].span[i] += n.span[i] - 1
+			update[i].next[i] = n.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+
+	s.size--
+	return true
+}
+
+// Floor returns the greatest key less than or equal to k, and the value stored under it.
+func (s *SkipList[K, V]) Floor(k K) (K, V, bool) {
+	update, _ := s.search(k)
+	if n := update[0].next[0]; n != nil && n.key == k {
+		return n.key, n.value, true
+	}
+	if update[0] != s.head {
+		return update[0].key, update[0].value, true
+	}
+
+	var (
+		key K
+		v   V
+	)
+	return key, v, false
+}
+
+// Ceiling returns the least key greater than or equal to k, and the value stored under it.
+func (s *SkipList[K, V]) Ceiling(k K) (K, V, bool) {
+	update, _ := s.search(k)
+	if n := update[0].next[0]; n != nil {
+		return n.key, n.value, true
+	}
+
+	var (
+		key K
+		v   V
+	)
+	return key, v, false
+}
+
+// Rank returns the zero-based position of k in key order, if it is in the list.
+func (s *SkipList[K, V]) Rank(k K) (int, bool) {
+	update, rank := s.search(k)
+	if n := update[0].next[0]; n != nil && n.key == k {
+		return rank[0], true
+	}
+	return -1, false
+}
+
+// ByRank returns the key and value at the zero-based position rank in key order.
+func (s *SkipList[K, V]) ByRank(rank int) (K, V, bool) {
+	if rank < 0 || rank >= s.size {
+		var (
+			key K
+			v   V
+		)
+		return key, v, false
+	}
+
+	// Ranks are one-based while walking, since the head sits at zero.
+	x, traversed := s.head, 0
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= rank+1 {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+	}
+	return x.key, x.value, true
+}
+
+// All returns an iterator over every key and value in the list in key order.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the keys from lo up to but not including hi, and their
+// values, in key order. The list must not be modified while iterating.
+func (s *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		update, _ := s.search(lo)
+		for n := update[0].next[0]; n != nil && n.key < hi; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package skiplist_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/george-e-shaw-iv/go/skiplist"
+	"github.com/stretchr/testify/assert"
+)
+
+func keys[V any](s *skiplist.SkipList[int, V]) []int {
+	var res []int
+	for k := range s.All() {
+		res = append(res, k)
+	}
+	return res
+}
+
+func TestSkipList(t *testing.T) {
+	s := skiplist.New[int, string](skiplist.WithSeed(1))
+
+	_, ok := s.Get(1)
+	assert.False(t, ok)
+
+	for _, k := range []int{5, 1, 9, 3, 7} {
+		s.Put(k, string(rune('a'+k)))
+	}
+	assert.Equal(t, 5, s.Len())
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, keys(s))
+
+	v, ok := s.Get(7)
+	assert.True(t, ok)
+	assert.Equal(t, "h", v)
+
+	// Putting an existing key should only replace its value.
+	s.Put(7, "seven")
+	v, _ = s.Get(7)
+	assert.Equal(t, "seven", v)
+	assert.Equal(t, 5, s.Len())
+
+	assert.True(t, s.Delete(5))
+	assert.False(t, s.Delete(5))
+	assert.False(t, s.Delete(4))
+	assert.Equal(t, []int{1, 3, 7, 9}, keys(s))
+	assert.Equal(t, 4, s.Len())
+}
+
+func TestSkipList_FloorCeiling(t *testing.T) {
+	s := skiplist.New[int, int](skiplist.WithSeed(1))
+	for _, k := range []int{10, 20, 30} {
+		s.Put(k, k*10)
+	}
+
+	tests := []struct {
+		Name         string
+		Key          int
+		Floor        int
+		FloorValue   int
+		FloorOK      bool
+		Ceiling      int
+		CeilingValue int
+		CeilingOK    bool
+	}{
+		{Name: "BelowAll", Key: 5, Ceiling: 10, CeilingOK: true, CeilingValue: 100},
+		{Name: "Exact", Key: 20, Floor: 20, FloorOK: true, FloorValue: 200, Ceiling: 20, CeilingOK: true, CeilingValue: 200},
+		{Name: "Between", Key: 25, Floor: 20, FloorOK: true, FloorValue: 200, Ceiling: 30, CeilingOK: true, CeilingValue: 300},
+		{Name: "AboveAll", Key: 35, Floor: 30, FloorOK: true, FloorValue: 300},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			k, v, ok := s.Floor(test.Key)
+			assert.Equal(t, test.FloorOK, ok)
+			assert.Equal(t, test.Floor, k)
+			assert.Equal(t, test.FloorValue, v)
+
+			k, v, ok = s.Ceiling(test.Key)
+			assert.Equal(t, test.CeilingOK, ok)
+			assert.Equal(t, test.Ceiling, k)
+			assert.Equal(t, test.CeilingValue, v)
+		})
+	}
+}
+
+func TestSkipList_Range(t *testing.T) {
+	s := skiplist.New[int, int](skiplist.WithSeed(1))
+	for i := 0; i < 10; i++ {
+		s.Put(i*2, i)
+	}
+
+	var got []int
+	for k := range s.Range(3, 11) {
+		got = append(got, k)
+	}
+	assert.Equal(t, []int{4, 6, 8, 10}, got)
+
+	got = nil
+	for k := range s.Range(4, 4) {
+		got = append(got, k)
+	}
+	assert.Empty(t, got)
+
+	// Stopping early should stop the walk.
+	got = nil
+	for k := range s.Range(0, 100) {
+		if k > 4 {
+			break
+		}
+		got = append(got, k)
+	}
+	assert.Equal(t, []int{0, 2, 4}, got)
+}
+
+func TestSkipList_Rank(t *testing.T) {
+	s := skiplist.New[int, int](skiplist.WithSeed(1))
+	for i := 1; i <= 5; i++ {
+		s.Put(i*10, i)
+	}
+
+	r, ok := s.Rank(30)
+	assert.True(t, ok)
+	assert.Equal(t, 2, r)
+
+	_, ok = s.Rank(35)
+	assert.False(t, ok)
+
+	k, v, ok := s.ByRank(4)
+	assert.True(t, ok)
+	assert.Equal(t, 50, k)
+	assert.Equal(t, 5, v)
+
+	_, _, ok = s.ByRank(5)
+	assert.False(t, ok)
+	_, _, ok = s.ByRank(-1)
+	assert.False(t, ok)
+}
+
+// TestSkipList_Random checks the list against a sorted slice through a long, seeded run of
+// random puts and deletes, which exercises the span bookkeeping on every level.
+func TestSkipList_Random(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	s := skiplist.New[int, int](skiplist.WithSeed(3))
+
+	var want []int
+	for i := 0; i < 5000; i++ {
+		k := rng.IntN(500)
+		idx, found := slices.BinarySearch(want, k)
+
+		if rng.IntN(3) == 0 {
+			assert.Equal(t, found, s.Delete(k))
+			if found {
+				want = slices.Delete(want, idx, idx+1)
+			}
+		} else {
+			s.Put(k, -k)
+			if !found {
+				want = slices.Insert(want, idx, k)
+			}
+		}
+	}
+
+	assert.Equal(t, want, keys(s))
+	assert.Equal(t, len(want), s.Len())
+	for i, k := range want {
+		r, ok := s.Rank(k)
+		assert.True(t, ok)
+		assert.Equal(t, i, r)
+
+		got, v, ok := s.ByRank(i)
+		assert.True(t, ok)
+		assert.Equal(t, k, got)
+		assert.Equal(t, -k, v)
+	}
+}
@@ -1,27 +1,41 @@
+// Package stack provides LIFO stacks. Top and Pop panic with ErrEmpty when the stack is
+// empty; TryTop and TryPop report it with a false ok instead.
 package stack
 
 import (
+	"errors"
+	"iter"
+	"slices"
 	"sync"
 
 	"github.com/george-e-shaw-iv/go/queue"
 )
 
+// ErrEmpty is what Top and Pop panic with when the stack is empty.
+var ErrEmpty = errors.New("stack is empty")
+
 type Stack[T any] interface {
 	Push(val T)
 	Top() T
 	Pop()
+
+	// TryTop returns the value on top of the stack, if there is one.
+	TryTop() (T, bool)
+
+	// TryPop removes and returns the value on top of the stack, if there is one.
+	TryPop() (T, bool)
+
 	Len() int
+	All() iter.Seq[T]
 }
 
 type Classic[T any] struct {
 	data []T
-	mu   *sync.Mutex
+	mu   sync.Mutex
 }
 
 func NewClassic[T any]() *Classic[T] {
-	return &Classic[T]{
-		mu: &sync.Mutex{},
-	}
+	return &Classic[T]{}
 }
 
 func (s *Classic[T]) Push(val T) {
@@ -31,11 +45,24 @@ func (s *Classic[T]) Push(val T) {
 	s.data = append(s.data, val)
 }
 
+// Top returns the value on top of the stack. It panics with ErrEmpty if the stack is empty.
 func (s *Classic[T]) Top() T {
+	val, ok := s.TryTop()
+	if !ok {
+		panic(ErrEmpty)
+	}
+	return val
+}
+
+func (s *Classic[T]) TryTop() (T, bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	return s.data[len(s.data)-1]
+	if len(s.data) == 0 {
+		var val T
+		return val, false
+	}
+	return s.data[len(s.data)-1], true
 }
 
 func (s *Classic[T]) Len() int {
@@ -45,11 +72,44 @@ func (s *Classic[T]) Len() int {
 	return len(s.data)
 }
 
+// Pop removes the value on top of the stack. It panics with ErrEmpty if the stack is empty.
 func (s *Classic[T]) Pop() {
+	if _, ok := s.TryPop(); !ok {
+		panic(ErrEmpty)
+	}
+}
+
+func (s *Classic[T]) TryPop() (T, bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if len(s.data) == 0 {
+		var val T
+		return val, false
+	}
+
+	// Zero the slot so that the backing array doesn't keep whatever val points to alive.
+	var zero T
+	val := s.data[len(s.data)-1]
+	s.data[len(s.data)-1] = zero
 	s.data = s.data[:len(s.data)-1]
+	return val, true
+}
+
+// All returns an iterator over the values in the stack from top to bottom. It iterates over
+// a snapshot taken when iteration starts, so the stack is free to change during iteration.
+func (s *Classic[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.mu.Lock()
+		data := slices.Clone(s.data)
+		s.mu.Unlock()
+
+		for i := len(data) - 1; i >= 0; i-- {
+			if !yield(data[i]) {
+				return
+			}
+		}
+	}
 }
 
 type QueueBased[T any] struct {
@@ -79,11 +139,20 @@ func (s *QueueBased[T]) Push(val T) {
 	s.staging = tmp
 }
 
+// Top returns the value on top of the stack. It panics with ErrEmpty if the stack is empty.
 func (s *QueueBased[T]) Top() T {
+	val, ok := s.TryTop()
+	if !ok {
+		panic(ErrEmpty)
+	}
+	return val
+}
+
+func (s *QueueBased[T]) TryTop() (T, bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	return s.main.Peek()
+	return s.main.TryPeek()
 }
 
 func (s *QueueBased[T]) Len() int {
@@ -93,9 +162,33 @@ func (s *QueueBased[T]) Len() int {
 	return s.main.Len()
 }
 
+// Pop removes the value on top of the stack. It panics with ErrEmpty if the stack is empty.
 func (s *QueueBased[T]) Pop() {
+	if _, ok := s.TryPop(); !ok {
+		panic(ErrEmpty)
+	}
+}
+
+func (s *QueueBased[T]) TryPop() (T, bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.main.Dequeue()
+	return s.main.TryDequeue()
+}
+
+// All returns an iterator over the values in the stack from top to bottom. It iterates over
+// a snapshot taken when iteration starts, so the stack is free to change during iteration.
+func (s *QueueBased[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// The front of main is always the top of the stack.
+		s.mu.Lock()
+		data := slices.Collect(s.main.All())
+		s.mu.Unlock()
+
+		for i := range data {
+			if !yield(data[i]) {
+				return
+			}
+		}
+	}
 }
@@ -1,6 +1,7 @@
 package stack_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/george-e-shaw-iv/go/stack"
@@ -49,5 +50,59 @@ func TestStack(t *testing.T) {
 
 			assert.Equal(t, 0, test.Implementation.Len())
 		})
+
+		t.Run(test.Name+"Empty", func(t *testing.T) {
+			_, ok := test.Implementation.TryTop()
+			assert.False(t, ok)
+			_, ok = test.Implementation.TryPop()
+			assert.False(t, ok)
+
+			assert.PanicsWithValue(t, stack.ErrEmpty, func() { test.Implementation.Top() })
+			assert.PanicsWithValue(t, stack.ErrEmpty, func() { test.Implementation.Pop() })
+
+			test.Implementation.Push(0)
+			test.Implementation.Push(1)
+
+			v, ok := test.Implementation.TryTop()
+			assert.True(t, ok)
+			assert.Equal(t, 1, v)
+
+			v, ok = test.Implementation.TryPop()
+			assert.True(t, ok)
+			assert.Equal(t, 1, v)
+
+			v, ok = test.Implementation.TryPop()
+			assert.True(t, ok)
+			assert.Equal(t, 0, v)
+			assert.Equal(t, 0, test.Implementation.Len())
+		})
+
+		t.Run(test.Name+"All", func(t *testing.T) {
+			test.Implementation.Push(0)
+			test.Implementation.Push(1)
+			test.Implementation.Push(2)
+
+			assert.Equal(t, []int{2, 1, 0}, slices.Collect(test.Implementation.All()))
+
+			// The stack is free to change during iteration.
+			for v := range test.Implementation.All() {
+				test.Implementation.Push(v)
+				if v == 1 {
+					break
+				}
+			}
+			assert.Equal(t, []int{1, 2, 2, 1, 0}, slices.Collect(test.Implementation.All()))
+		})
 	}
 }
+
+func TestClassic_ZeroValue(t *testing.T) {
+	var s stack.Classic[int]
+
+	_, ok := s.TryPop()
+	assert.False(t, ok)
+
+	s.Push(0)
+	assert.Equal(t, 0, s.Top())
+	assert.Equal(t, 1, s.Len())
+}
@@ -1,5 +1,7 @@
 package trie
 
+import "iter"
+
 type node struct {
 	data [26]*node
 	end  bool
@@ -119,3 +121,46 @@ func (t *Trie) GetAllWordsWithPrefix(prefix string) []string {
 	t.getAllWords(n, prefix, &res)
 	return res
 }
+
+// All returns an iterator over the words in the Trie in lexicographical order.
+func (t *Trie) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		t.walk(t.root, nil, yield)
+	}
+}
+
+// AllWithPrefix returns an iterator over the words in the Trie that start with prefix, in
+// lexicographical order.
+func (t *Trie) AllWithPrefix(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		n := t.root
+		for i := range prefix {
+			idx := t.normalizeCharIdx(prefix[i])
+			if next := n.data[idx]; next != nil {
+				n = next
+				continue
+			}
+			return
+		}
+
+		t.walk(n, []byte(prefix), yield)
+	}
+}
+
+// walk yields every word below node, returning false as soon as yield asks to stop.
+func (t *Trie) walk(node *node, prefix []byte, yield func(string) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	if node.end && !yield(string(prefix)) {
+		return false
+	}
+
+	for i := range node.data {
+		if !t.walk(node.data[i], append(prefix, t.reverseCharIdx(byte(i))), yield) {
+			return false
+		}
+	}
+	return true
+}
@@ -1,6 +1,7 @@
 package trie_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/george-e-shaw-iv/go/trie"
@@ -41,3 +42,26 @@ func TestTrie_GetAllWordsWithPrefix(t *testing.T) {
 	assert.Contains(t, words, "bar")
 	assert.Contains(t, words, "baz")
 }
+
+func TestTrie_All(t *testing.T) {
+	tr := trie.NewTrie("foo", "bar", "baz", "ba")
+
+	assert.Equal(t, []string{"ba", "bar", "baz", "foo"}, slices.Collect(tr.All()))
+
+	// Stopping early shouldn't visit the rest of the Trie.
+	var visited []string
+	for word := range tr.All() {
+		visited = append(visited, word)
+		if word == "bar" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"ba", "bar"}, visited)
+}
+
+func TestTrie_AllWithPrefix(t *testing.T) {
+	tr := trie.NewTrie("foo", "bar", "baz")
+
+	assert.Equal(t, []string{"bar", "baz"}, slices.Collect(tr.AllWithPrefix("ba")))
+	assert.Empty(t, slices.Collect(tr.AllWithPrefix("qu")))
+}